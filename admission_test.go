@@ -0,0 +1,73 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+var errDisabled = errors.New("tenant disabled")
+
+func TestWithAdmission_RejectsJobs(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[string](context.TODO(), 2, nursery.WithAdmission(func(job nursery.JobInfo) error {
+		if job.Tag == "disabled" {
+			return errDisabled
+		}
+
+		return nil
+	}))
+
+	bounded.GoTagged("enabled", func() string { return "enabled" })
+
+	ran := false
+	message := fmt.Sprint(recovered(func() {
+		bounded.GoTagged("disabled", func() string {
+			ran = true
+
+			return "disabled"
+		})
+	}))
+
+	if message != errDisabled.Error() || ran {
+		t.Fatalf("expected the rejected job not to run, got ran %t, %q", ran, message)
+	}
+
+	if results := bounded.Wait(); len(results) != 1 || results[0] != "enabled" {
+		t.Fatalf("expected only the admitted job to run, got %v", results)
+	}
+}
+
+func TestBounded_TryGo(t *testing.T) {
+	t.Parallel()
+
+	admitted := 0
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithAdmission(func(nursery.JobInfo) error {
+		if admitted == 2 {
+			return errDisabled
+		}
+
+		admitted++
+
+		return nil
+	}))
+
+	for job := range 3 {
+		err := bounded.TryGo(func() int { return job })
+		if (job < 2 && err != nil) || (job == 2 && !errors.Is(err, errDisabled)) {
+			t.Fatalf("expected job %d to be admitted, unless it exceeds the quota, got %v", job, err)
+		}
+	}
+
+	if results := bounded.Wait(); len(results) != 2 {
+		t.Fatalf("expected the admitted jobs to run, got %v", results)
+	}
+
+	if err := bounded.TryGo(func() int { return 0 }); !errors.Is(err, nursery.ErrClosed) {
+		t.Fatalf("expected a closed nursery to reject jobs, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package nursery
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Number is satisfied by all integer and floating point types.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum returns the sum of all results, or zero if there are none.
+func Sum[N Number](results []N) N {
+	var sum N
+
+	for _, result := range results {
+		sum += result
+	}
+
+	return sum
+}
+
+// Min returns the smallest result, or false if there are no results.
+func Min[T cmp.Ordered](results []T) (T, bool) {
+	if len(results) == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return slices.Min(results), true
+}
+
+// Max returns the largest result, or false if there are no results.
+func Max[T cmp.Ordered](results []T) (T, bool) {
+	if len(results) == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return slices.Max(results), true
+}
+
+// Count returns the number of results matching the predicate.
+func Count[R any](results []R, predicate func(R) bool) int {
+	count := 0
+
+	for _, result := range results {
+		if predicate(result) {
+			count++
+		}
+	}
+
+	return count
+}
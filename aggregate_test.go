@@ -0,0 +1,45 @@
+package nursery_test
+
+import (
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestAggregators(t *testing.T) {
+	t.Parallel()
+
+	results := []float64{3, -1.5, 7, 0}
+
+	if sum := nursery.Sum(results); sum != 8.5 {
+		t.Fatalf("expected sum 8.5, got %v", sum)
+	}
+
+	if smallest, ok := nursery.Min(results); !ok || smallest != -1.5 {
+		t.Fatalf("expected min -1.5, got %v", smallest)
+	}
+
+	if largest, ok := nursery.Max(results); !ok || largest != 7 {
+		t.Fatalf("expected max 7, got %v", largest)
+	}
+
+	if positive := nursery.Count(results, func(result float64) bool { return result > 0 }); positive != 2 {
+		t.Fatalf("expected 2 positive results, got %d", positive)
+	}
+}
+
+func TestAggregators_Empty(t *testing.T) {
+	t.Parallel()
+
+	if sum := nursery.Sum([]int(nil)); sum != 0 {
+		t.Fatalf("expected sum 0, got %d", sum)
+	}
+
+	if _, ok := nursery.Min([]string(nil)); ok {
+		t.Fatal("expected no min for empty results")
+	}
+
+	if _, ok := nursery.Max([]string(nil)); ok {
+		t.Fatal("expected no max for empty results")
+	}
+}
@@ -0,0 +1,86 @@
+package nursery
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// Attempt describes a single execution of a job started with
+// [Unbounded.GoAttempt] or [Bounded.GoAttempt].
+type Attempt struct {
+	number   int
+	requeued bool
+	delay    time.Duration
+	// lane is the slot of a [Bounded] nursery, the attempt runs in, job the index of the job.
+	lane int
+	job  int
+}
+
+func firstAttempt() *Attempt {
+	return &Attempt{number: 1, requeued: false, delay: 0, lane: 0, job: 0}
+}
+
+func (attempt *Attempt) next() *Attempt {
+	return &Attempt{number: attempt.number + 1, requeued: false, delay: 0, lane: 0, job: attempt.job}
+}
+
+// Number returns how often the job was started, including this attempt.
+func (attempt *Attempt) Number() int {
+	return attempt.number
+}
+
+// Requeue discards the result of this attempt and starts the job again,
+// once the given delay passed.
+// The job does not occupy a slot of a [Bounded] nursery while waiting.
+func (attempt *Attempt) Requeue(after time.Duration) {
+	attempt.requeued = true
+	attempt.delay = after
+}
+
+// GoAttempt is like [Unbounded.Go], but the job may requeue itself via the given [Attempt].
+func (nursery *Unbounded[R]) GoAttempt(job func(attempt *Attempt) R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.startSoon(JobInfo{Index: 0, Tag: "", Name: ""}, func() R {
+		for attempt := firstAttempt(); ; attempt = attempt.next() {
+			result := job(attempt)
+			if !attempt.requeued {
+				return result
+			}
+
+			sleep(context.Background(), attempt.delay)
+		}
+	})
+}
+
+// GoAttempt is like [Bounded.Go], but the job may requeue itself via the given [Attempt].
+// Requeued jobs are scheduled again after the delay, so they wait behind jobs submitted meanwhile.
+// If the [Bounded] nursery's context is finished, requeued jobs will not be run again.
+func (nursery *Bounded[R]) GoAttempt(job func(attempt *Attempt) R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.submit(nursery.slots, JobInfo{Index: 0, Tag: "", Name: ""}, job)
+}
+
+// sleep waits for the given duration and reports whether the context is still alive.
+func sleep(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// jitter returns a random delay up to the maximum, see [WithStartJitter].
+func jitter(maximum time.Duration) time.Duration {
+	return rand.N(maximum) //nolint:gosec // jitter needs no secure randomness
+}
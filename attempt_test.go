@@ -0,0 +1,87 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestUnbounded_GoAttemptRequeues(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+	unbounded.GoAttempt(func(attempt *nursery.Attempt) int {
+		if attempt.Number() < 3 {
+			attempt.Requeue(time.Millisecond)
+		}
+
+		return attempt.Number()
+	})
+
+	if results := unbounded.Wait(); !slices.Equal(results, []int{3}) {
+		t.Fatalf("expected only the result of the third attempt, got %v", results)
+	}
+}
+
+func TestBounded_GoAttemptFreesSlotWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[string](context.TODO(), 1)
+
+	bounded.GoAttempt(func(attempt *nursery.Attempt) string {
+		if attempt.Number() == 1 {
+			attempt.Requeue(10 * time.Millisecond)
+		}
+
+		return "requeued"
+	})
+
+	bounded.Go(func() string {
+		return "other"
+	})
+
+	if results := bounded.Wait(); !slices.Equal(results, []string{"other", "requeued"}) {
+		t.Fatalf("expected other job to run while requeued job waits, got %v", results)
+	}
+}
+
+func TestBounded_GoAttemptStopsOnCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	bounded := nursery.NewBounded[int](ctx, 1)
+
+	bounded.GoAttempt(func(attempt *nursery.Attempt) int {
+		cancel()
+		attempt.Requeue(time.Hour)
+
+		return attempt.Number()
+	})
+
+	if results := bounded.Wait(); len(results) != 0 {
+		t.Fatalf("expected requeued job to be dropped, got %v", results)
+	}
+}
+
+func TestBounded_GoAttemptWaitsForDelay(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[time.Duration](context.TODO(), 1)
+
+	start := time.Now()
+
+	bounded.GoAttempt(func(attempt *nursery.Attempt) time.Duration {
+		if attempt.Number() == 1 {
+			attempt.Requeue(20 * time.Millisecond)
+		}
+
+		return time.Since(start)
+	})
+
+	if results := bounded.Wait(); len(results) != 1 || results[0] < 20*time.Millisecond {
+		t.Fatalf("expected the requeued attempt to wait for its delay, got %v", results)
+	}
+}
@@ -0,0 +1,77 @@
+package nursery
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// AuditRecord is written per job by [WithAuditLog].
+// Outcome is either "completed", "failed" if the result reports an error,
+// i.e. it is an error or a [Tuple] with an error as second component,
+// "skipped" if the job never ran or was not run again, as the nursery was stopped,
+// "exited" if the job called [runtime.Goexit], see [EventExit], or "panicked" if it panicked, see [EventPanic].
+type AuditRecord struct {
+	Index   int        `json:"index"`
+	Tag     string     `json:"tag,omitempty"`
+	Name    string     `json:"name,omitempty"`
+	Start   *time.Time `json:"start,omitempty"`
+	End     time.Time  `json:"end"`
+	Outcome string     `json:"outcome"`
+	Error   string     `json:"error,omitempty"`
+}
+
+// auditLog writes audit records as JSON lines.
+type auditLog struct {
+	mx      sync.Mutex
+	encoder *json.Encoder
+}
+
+func newAuditLog(writer io.Writer) *auditLog {
+	return &auditLog{mx: sync.Mutex{}, encoder: json.NewEncoder(writer)}
+}
+
+// audit counts the outcome of the finished job, see [Summary],
+// and writes a record for it, if the nursery keeps an audit log.
+// The start is zero, if the job never ran.
+func (nursery *Unbounded[R]) audit(job JobInfo, start time.Time, kind EventKind, result R) {
+	err := errorOf(result)
+	nursery.tally.count(kind, err)
+
+	if nursery.auditLog == nil {
+		return
+	}
+
+	record := AuditRecord{
+		Index:   job.Index,
+		Tag:     job.Tag,
+		Name:    job.Name,
+		Start:   nil,
+		End:     time.Now(),
+		Outcome: "completed",
+		Error:   "",
+	}
+
+	if !start.IsZero() {
+		record.Start = &start
+	}
+
+	switch {
+	case kind == EventSkip:
+		record.Outcome = "skipped"
+	case kind == EventExit:
+		record.Outcome = "exited"
+	case kind == EventPanic:
+		record.Outcome = "panicked"
+	case err != nil:
+		record.Outcome = "failed"
+		record.Error = err.Error()
+	}
+
+	nursery.auditLog.mx.Lock()
+	defer nursery.auditLog.mx.Unlock()
+
+	// Failing to write the audit log must not fail the job.
+	_ = nursery.auditLog.encoder.Encode(record)
+}
@@ -0,0 +1,51 @@
+package nursery_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithAuditLog(t *testing.T) {
+	t.Parallel()
+
+	var log bytes.Buffer
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	bounded := nursery.NewBounded[error](ctx, 1, nursery.WithAuditLog(&log))
+
+	bounded.GoTagged("ok", func() error { return nil })
+	bounded.GoTagged("failed", func() error {
+		cancel()
+
+		return errors.New("broken")
+	})
+	bounded.GoTagged("skipped", func() error { return nil })
+	bounded.Wait()
+
+	outcomes := map[string]nursery.AuditRecord{}
+
+	decoder := json.NewDecoder(&log)
+	for decoder.More() {
+		var record nursery.AuditRecord
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatal(err)
+		}
+
+		outcomes[record.Tag] = record
+	}
+
+	for tag, want := range map[string]string{"ok": "completed", "failed": "failed", "skipped": "skipped"} {
+		if outcomes[tag].Outcome != want {
+			t.Fatalf("expected job %q to be %s, got %+v", tag, want, outcomes[tag])
+		}
+	}
+
+	if outcomes["failed"].Error != "broken" || outcomes["failed"].Start == nil || outcomes["skipped"].Start != nil {
+		t.Fatalf("expected error and start times to be recorded, got %+v", outcomes)
+	}
+}
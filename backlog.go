@@ -0,0 +1,137 @@
+package nursery
+
+import (
+	"errors"
+	"sync"
+)
+
+// QueuePolicy decides what happens to a job submitted via Go, once the queue of a [Bounded] nursery is full,
+// see [WithQueueLimit].
+type QueuePolicy int
+
+const (
+	// QueueBlock blocks the submitter, until there is room in the queue, or the nursery is stopped.
+	QueueBlock QueuePolicy = iota
+	// QueueDrop drops the job silently.
+	QueueDrop
+	// QueueReject panics with [ErrQueueFull], like Go panics with [ErrClosed].
+	QueueReject
+)
+
+// backlog limits the jobs of a [Bounded] nursery, that were accepted, but did not finish yet,
+// so they are not queued without limit, see [WithQueueLimit].
+type backlog struct {
+	mx       sync.Mutex
+	limit    int
+	accepted int
+	policy   QueuePolicy
+	// freed is closed, once a job finished, while submitters wait for room.
+	freed chan struct{}
+}
+
+func newBacklog(limit int, policy QueuePolicy) *backlog {
+	return &backlog{mx: sync.Mutex{}, limit: limit, accepted: 0, policy: policy, freed: nil}
+}
+
+// acquire blocks until there is room for another job, or stopping is closed, and accepts the job.
+func (backlog *backlog) acquire(stopping <-chan struct{}) {
+	backlog.mx.Lock()
+
+	for backlog.accepted >= backlog.limit {
+		if backlog.freed == nil {
+			backlog.freed = make(chan struct{})
+		}
+
+		freed := backlog.freed
+		backlog.mx.Unlock()
+
+		select {
+		case <-freed:
+		case <-stopping:
+			// The job is skipped right away, so it must not wait for room.
+			backlog.add()
+
+			return
+		}
+
+		backlog.mx.Lock()
+	}
+
+	backlog.accepted++
+	backlog.mx.Unlock()
+}
+
+// tryAcquire accepts a job, if there is room for it.
+func (backlog *backlog) tryAcquire() bool {
+	backlog.mx.Lock()
+	defer backlog.mx.Unlock()
+
+	if backlog.accepted >= backlog.limit {
+		return false
+	}
+
+	backlog.accepted++
+
+	return true
+}
+
+// add accepts a job, even if there is no room, e.g. for children of running jobs, as they would deadlock otherwise.
+func (backlog *backlog) add() {
+	backlog.mx.Lock()
+	defer backlog.mx.Unlock()
+
+	backlog.accepted++
+}
+
+// release marks an accepted job as finished, which makes room for a waiting submitter.
+func (backlog *backlog) release() {
+	backlog.mx.Lock()
+	defer backlog.mx.Unlock()
+
+	backlog.accepted--
+
+	if backlog.freed != nil {
+		close(backlog.freed)
+		backlog.freed = nil
+	}
+}
+
+// reserve accepts a job, that is about to be submitted, if there is room, see [WithQueueLimit].
+// Jobs submitted via TryGo never block, the others follow the queue policy.
+// If it is not submitted after all, it must be released again.
+func (nursery *Bounded[R]) reserve(try bool) error {
+	backlog := nursery.backlog
+
+	switch {
+	case backlog == nil:
+		return nil
+	case !try && backlog.policy == QueueBlock:
+		backlog.acquire(nursery.stopping)
+
+		return nil
+	case backlog.tryAcquire():
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// reject panics with the reason, why a job submitted via Go was not accepted,
+// unless the job is dropped, as the nursery is stopped, see [Bounded.Stop], or its queue is full, see [QueueDrop],
+// which counts as skipped.
+func (nursery *Bounded[R]) reject(err error) {
+	if errors.Is(err, ErrAborted) || (errors.Is(err, ErrQueueFull) && nursery.backlog.policy == QueueDrop) {
+		nursery.inner.skipped.Add(1)
+
+		return
+	}
+
+	panic(err)
+}
+
+// unreserve releases a job, that was reserved, but not submitted.
+func (nursery *Bounded[R]) unreserve() {
+	if nursery.backlog != nil {
+		nursery.backlog.release()
+	}
+}
@@ -0,0 +1,103 @@
+package nursery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithBlockingSubmit_BlocksUntilSlotIsFree(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithBlockingSubmit())
+	release := make(chan struct{})
+
+	bounded.Go(func() int {
+		<-release
+
+		return 1
+	})
+
+	submitted := make(chan struct{})
+
+	go func() {
+		defer close(submitted)
+
+		bounded.Go(func() int { return 2 })
+	}()
+
+	select {
+	case <-submitted:
+		t.Fatal("expected the submitter to block, while the slot is busy")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+
+	select {
+	case <-submitted:
+	case <-time.After(time.Second):
+		t.Fatal("expected the submitter to continue, once the slot was free")
+	}
+
+	if results := bounded.Wait(); len(results) != 2 {
+		t.Fatalf("expected both jobs to run, got %v", results)
+	}
+}
+
+func TestWithBlockingSubmit_StopUnblocks(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithBlockingSubmit())
+	started, release := make(chan struct{}), make(chan struct{})
+
+	bounded.Go(func() int {
+		close(started)
+		<-release
+
+		return 1
+	})
+
+	submitted := make(chan struct{})
+
+	go func() {
+		defer close(submitted)
+
+		bounded.Go(func() int { return 2 })
+	}()
+
+	<-started
+	bounded.Stop()
+
+	select {
+	case <-submitted:
+	case <-time.After(time.Second):
+		t.Fatal("expected the submitter to continue, once the nursery was stopped")
+	}
+
+	close(release)
+
+	if results := bounded.Wait(); len(results) != 1 {
+		t.Fatalf("expected the job submitted after stopping to be skipped, got %v", results)
+	}
+}
+
+func TestWithBlockingSubmit_ScopedJobsDoNotBlock(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithBlockingSubmit())
+
+	bounded.GoScoped(func(Go nursery.Scope[int]) int {
+		for range 3 {
+			Go(func(nursery.Scope[int]) int { return 1 })
+		}
+
+		return 0
+	})
+
+	if results := bounded.Wait(); len(results) != 4 {
+		t.Fatalf("expected all jobs to run, got %v", results)
+	}
+}
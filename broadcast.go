@@ -0,0 +1,144 @@
+package nursery
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Overflow is the policy of a [Subscriber], once its buffer is full.
+type Overflow int
+
+const (
+	// Block makes the broadcast wait, until the subscriber caught up,
+	// which holds back all other subscribers and the collection of results.
+	Block Overflow = iota
+	// DropNewest drops the result, that does not fit into the buffer anymore.
+	DropNewest
+	// DropOldest drops the oldest buffered result, to make room for the new one.
+	DropOldest
+)
+
+// Subscriber consumes every result of a broadcast, see [Broadcast].
+type Subscriber[R any] struct {
+	// Consume is called with every result on a goroutine of the subscriber.
+	Consume func(result R)
+	// Buffer is the number of results buffered, while Consume is busy.
+	// It must be at least 1, unless the overflow policy is [Block].
+	Buffer int
+	// Overflow is the policy, once the buffer is full.
+	Overflow Overflow
+}
+
+// BroadcastSink hands every result to multiple subscribers, see [Broadcast].
+// It is safe for concurrent use.
+type BroadcastSink[R any] struct {
+	subscribers []*subscription[R]
+	consumers   sync.WaitGroup
+	closed      sync.Once
+}
+
+type subscription[R any] struct {
+	results  chan R
+	overflow Overflow
+	dropped  atomic.Int64
+}
+
+// Broadcast returns a sink, that hands every result to each of the subscribers, with independent buffers,
+// e.g. one writing to storage while another updates metrics,
+// to be passed to [WithSink] via its Write method.
+// It starts a goroutine per subscriber, Close must be called once the nursery is finished.
+func Broadcast[R any](subscribers ...Subscriber[R]) *BroadcastSink[R] {
+	sink := &BroadcastSink[R]{
+		subscribers: make([]*subscription[R], len(subscribers)),
+		consumers:   sync.WaitGroup{},
+		closed:      sync.Once{},
+	}
+
+	for i, subscriber := range subscribers {
+		if subscriber.Consume == nil {
+			panic("consume must not be nil")
+		}
+
+		if subscriber.Overflow != Block && subscriber.Buffer < 1 {
+			panic(fmt.Sprintf("buffer of subscriber %d must be at least 1 to drop results, but was %d", i, subscriber.Buffer))
+		}
+
+		subscription := &subscription[R]{
+			results:  make(chan R, max(subscriber.Buffer, 0)),
+			overflow: subscriber.Overflow,
+			dropped:  atomic.Int64{},
+		}
+		sink.subscribers[i] = subscription
+
+		sink.consumers.Add(1)
+
+		go func() {
+			defer sink.consumers.Done()
+
+			for result := range subscription.results {
+				subscriber.Consume(result)
+			}
+		}()
+	}
+
+	return sink
+}
+
+// Write hands the result to all subscribers, according to their overflow policies.
+func (sink *BroadcastSink[R]) Write(result R) {
+	for _, subscription := range sink.subscribers {
+		subscription.write(result)
+	}
+}
+
+func (subscription *subscription[R]) write(result R) {
+	if subscription.overflow == Block {
+		subscription.results <- result
+
+		return
+	}
+
+	for {
+		select {
+		case subscription.results <- result:
+			return
+		default:
+		}
+
+		if subscription.overflow == DropNewest {
+			subscription.dropped.Add(1)
+
+			return
+		}
+
+		// The subscriber may have taken the oldest result in the meantime.
+		select {
+		case <-subscription.results:
+			subscription.dropped.Add(1)
+		default:
+		}
+	}
+}
+
+// Close waits until all subscribers consumed the buffered results, results must not be written afterwards.
+func (sink *BroadcastSink[R]) Close() {
+	sink.closed.Do(func() {
+		for _, subscription := range sink.subscribers {
+			close(subscription.results)
+		}
+	})
+
+	sink.consumers.Wait()
+}
+
+// Dropped returns the number of results dropped per subscriber, in the order they were given.
+func (sink *BroadcastSink[R]) Dropped() []int64 {
+	dropped := make([]int64, len(sink.subscribers))
+
+	for i, subscription := range sink.subscribers {
+		dropped[i] = subscription.dropped.Load()
+	}
+
+	return dropped
+}
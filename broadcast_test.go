@@ -0,0 +1,95 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBroadcast_EverySubscriberGetsEveryResult(t *testing.T) {
+	t.Parallel()
+
+	var stored []int
+
+	var sum atomic.Int64
+
+	sink := nursery.Broadcast(
+		nursery.Subscriber[int]{
+			Consume:  func(result int) { stored = append(stored, result) },
+			Buffer:   0,
+			Overflow: nursery.Block,
+		},
+		nursery.Subscriber[int]{
+			Consume:  func(result int) { sum.Add(int64(result)) },
+			Buffer:   4,
+			Overflow: nursery.Block,
+		},
+	)
+
+	nursery.WithBounded(context.TODO(), 3, func(Go nursery.Go[int]) {
+		for job := range 10 {
+			Go(func() int { return job })
+		}
+	}, nursery.WithSink(sink.Write))
+
+	sink.Close()
+
+	slices.Sort(stored)
+
+	if !slices.Equal(stored, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) || sum.Load() != 45 {
+		t.Fatalf("expected both subscribers to get all results, got %v and %d", stored, sum.Load())
+	}
+}
+
+func TestBroadcast_DropsForSlowSubscribers(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+
+	var newest, oldest []int
+
+	sink := nursery.Broadcast(
+		nursery.Subscriber[int]{
+			Consume: func(result int) {
+				<-release
+
+				newest = append(newest, result)
+			},
+			Buffer:   1,
+			Overflow: nursery.DropNewest,
+		},
+		nursery.Subscriber[int]{
+			Consume: func(result int) {
+				<-release
+
+				oldest = append(oldest, result)
+			},
+			Buffer:   1,
+			Overflow: nursery.DropOldest,
+		},
+	)
+
+	// Each subscriber is busy with the first result, and buffers one more.
+	for result := range 5 {
+		sink.Write(result)
+	}
+
+	close(release)
+	sink.Close()
+
+	dropped := sink.Dropped()
+	if dropped[0]+int64(len(newest)) != 5 || dropped[1]+int64(len(oldest)) != 5 {
+		t.Fatalf("expected every result to be consumed or dropped, got %v, %v and %v", dropped, newest, oldest)
+	}
+
+	if newest[len(newest)-1] == 4 && dropped[0] > 0 {
+		t.Fatalf("expected the newest results to be dropped, got %v", newest)
+	}
+
+	if oldest[len(oldest)-1] != 4 {
+		t.Fatalf("expected the oldest results to be dropped, got %v", oldest)
+	}
+}
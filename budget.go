@@ -0,0 +1,65 @@
+package nursery
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrBudgetExhausted is the cause of contexts, whose timeout was cut short by a [Budget].
+var ErrBudgetExhausted = errors.New("timeout budget exhausted")
+
+// Budget is a total amount of time, that the timeouts of jobs draw from,
+// so a fan-out spends at most that much time blocking, regardless of how many jobs it runs.
+// It is safe for concurrent use.
+type Budget struct {
+	mx        sync.Mutex
+	remaining time.Duration
+}
+
+// NewBudget returns a budget with the given total.
+func NewBudget(total time.Duration) *Budget {
+	return &Budget{mx: sync.Mutex{}, remaining: total}
+}
+
+// Remaining returns the time left in the budget, excluding the time reserved by running jobs.
+func (budget *Budget) Remaining() time.Duration {
+	budget.mx.Lock()
+	defer budget.mx.Unlock()
+
+	return budget.remaining
+}
+
+// WithTimeout returns a context derived from ctx, that times out after the timeout,
+// or less if the budget does not have that much left, as the timeout is reserved from the budget.
+// Once the budget is exhausted, the context is done right away with [ErrBudgetExhausted] as cause.
+//
+// Calling the returned function cancels the context and refunds the unused part of the reservation,
+// so only the time actually spent is drawn from the budget.
+func (budget *Budget) WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	budget.mx.Lock()
+	reserved := min(timeout, budget.remaining)
+	budget.remaining -= reserved
+	budget.mx.Unlock()
+
+	cause := error(context.DeadlineExceeded)
+	if reserved < timeout {
+		cause = ErrBudgetExhausted
+	}
+
+	ctx, cancel := context.WithTimeoutCause(ctx, reserved, cause)
+	start := time.Now()
+
+	var once sync.Once
+
+	return ctx, func() {
+		once.Do(func() {
+			cancel()
+
+			budget.mx.Lock()
+			budget.remaining += reserved - min(time.Since(start), reserved)
+			budget.mx.Unlock()
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBudget_RefundsUnusedTime(t *testing.T) {
+	t.Parallel()
+
+	budget := nursery.NewBudget(time.Hour)
+
+	_, cancel := budget.WithTimeout(context.TODO(), time.Minute)
+	if remaining := budget.Remaining(); remaining != 59*time.Minute {
+		t.Fatalf("expected the timeout to be reserved, got %s remaining", remaining)
+	}
+
+	cancel()
+
+	if remaining := budget.Remaining(); remaining < 59*time.Minute+59*time.Second {
+		t.Fatalf("expected the unused time to be refunded, got %s remaining", remaining)
+	}
+}
+
+func TestBudget_CapsTotalBlockingTime(t *testing.T) {
+	t.Parallel()
+
+	budget := nursery.NewBudget(10 * time.Millisecond)
+	start := time.Now()
+
+	errs := nursery.WithBounded(context.TODO(), 2, func(Go nursery.Go[error]) {
+		for range 10 {
+			Go(func() error {
+				ctx, cancel := budget.WithTimeout(context.TODO(), time.Second)
+				defer cancel()
+
+				<-ctx.Done()
+
+				return context.Cause(ctx)
+			})
+		}
+	})
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the budget to cap the blocking time, but took %s", elapsed)
+	}
+
+	for _, err := range errs {
+		if !errors.Is(err, nursery.ErrBudgetExhausted) {
+			t.Fatalf("expected all timeouts to be cut short by the budget, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package nursery
+
+import "fmt"
+
+// GoIn is like [Bounded.Go], but runs the job in the named bulkhead, see [WithBulkheads].
+// It panics, if the nursery has no such bulkhead.
+func (nursery *Bounded[R]) GoIn(bulkhead string, job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	queue, ok := nursery.bulkheads[bulkhead]
+	if !ok {
+		panic(fmt.Sprintf("nursery has no bulkhead %q", bulkhead))
+	}
+
+	nursery.submit(queue, JobInfo{Index: 0, Tag: "", Name: ""}, func(*Attempt) R { return job() })
+}
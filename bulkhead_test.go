@@ -0,0 +1,65 @@
+package nursery_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBounded_GoInIsolatesBulkheads(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[string](context.TODO(), 1, nursery.WithBulkheads(map[string]int{"db": 2}))
+
+	release := make(chan struct{})
+
+	var blocked atomic.Int32
+
+	// Exhaust the bulkhead with more jobs than it has slots.
+	for range 3 {
+		bounded.GoIn("db", func() string {
+			blocked.Add(1)
+			<-release
+
+			return "db"
+		})
+	}
+
+	done := make(chan struct{})
+
+	bounded.Go(func() string {
+		close(done)
+
+		return "other"
+	})
+
+	// The other job must run, although the bulkhead is exhausted.
+	<-done
+
+	if blocked.Load() > 2 {
+		t.Fatalf("expected at most 2 jobs in the bulkhead, but %d ran", blocked.Load())
+	}
+
+	close(release)
+
+	if results := bounded.Wait(); len(results) != 4 {
+		t.Fatalf("expected all 4 jobs to complete, got %v", results)
+	}
+}
+
+func TestBounded_GoInUnknownBulkhead(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1)
+	defer bounded.Wait()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected unknown bulkhead to panic")
+		}
+	}()
+
+	bounded.GoIn("db", func() int { return 1 })
+}
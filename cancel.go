@@ -0,0 +1,101 @@
+package nursery
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// ErrStopped is the reason of a [Token], once the nursery was stopped via [Bounded.Stop].
+var ErrStopped = errors.New("nursery stopped")
+
+// Token is a lightweight view on the cancellation state of a nursery,
+// so code inside of jobs, that is not context aware, can poll it cheaply.
+// It is safe for concurrent use.
+type Token struct {
+	reason atomic.Pointer[error]
+}
+
+// Cancelled reports whether the nursery was stopped or cancelled.
+func (token *Token) Cancelled() bool {
+	return token.reason.Load() != nil
+}
+
+// Reason returns why the nursery was stopped or cancelled, or nil if it was not:
+// [ErrStopped] for [Bounded.Stop], [context.Canceled] for [Bounded.Cancel],
+// [ErrQuorumUnreachable] for [WithQuorum], [ErrQuorumReached] for [WithBoundedQuorum],
+// or the cause of the nursery's context or the one given to [Bounded.WaitUntil], once it is done.
+func (token *Token) Reason() error {
+	if reason := token.reason.Load(); reason != nil {
+		return *reason
+	}
+
+	return nil
+}
+
+// cancel sets the reason, unless the token is already cancelled.
+func (token *Token) cancel(reason error) {
+	token.reason.CompareAndSwap(nil, &reason)
+}
+
+// Stop cancels the nursery softly, like a graceful shutdown:
+// queued and requeued jobs are dropped, jobs submitted later are dropped right away,
+// and running jobs are asked to wrap up via [Bounded.Stopping], but their context stays intact.
+// Stop is called, once the nursery's context is done.
+func (nursery *Bounded[R]) Stop() {
+	nursery.token.cancel(ErrStopped)
+	nursery.stopped.Do(func() {
+		close(nursery.stopping)
+		nursery.slots.close()
+
+		for _, bulkhead := range nursery.bulkheads {
+			bulkhead.close()
+		}
+
+		nursery.delays.close()
+	})
+}
+
+// Cancel cancels the nursery forcefully: it stops the nursery like [Bounded.Stop]
+// and cancels the context of the jobs, see [Bounded.Context].
+func (nursery *Bounded[R]) Cancel() {
+	nursery.abort(context.Canceled)
+}
+
+// abort cancels the nursery like [Bounded.Cancel], with the reason as cause of the jobs' context.
+func (nursery *Bounded[R]) abort(reason error) {
+	nursery.token.cancel(reason)
+	nursery.cancel(reason)
+	nursery.Stop()
+}
+
+// Stopping returns a channel, that is closed once the nursery is stopped, see [Bounded.Stop].
+// Long-running jobs should select on it, to wrap up and return early.
+func (nursery *Bounded[R]) Stopping() <-chan struct{} {
+	return nursery.stopping
+}
+
+// Token returns the cancellation token of the nursery's jobs.
+func (nursery *Bounded[R]) Token() *Token {
+	return nursery.token
+}
+
+// Context returns the context for the nursery's jobs.
+// It is derived from the nursery's context, and canceled by [Bounded.Cancel], or once Wait returns.
+func (nursery *Bounded[R]) Context() context.Context {
+	return nursery.ctx
+}
+
+// isStopping reports whether the nursery is stopped, or about to be, as its context is done.
+func (nursery *Bounded[R]) isStopping() bool {
+	if nursery.ctx.Err() != nil {
+		return true
+	}
+
+	select {
+	case <-nursery.stopping:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,113 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBounded_StopLetsRunningJobsWrapUp(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[string](context.TODO(), 1)
+	started := make(chan struct{})
+
+	bounded.Go(func() string {
+		close(started)
+		<-bounded.Stopping()
+
+		if bounded.Context().Err() != nil {
+			return "cancelled"
+		}
+
+		return "wrapped up"
+	})
+	bounded.Go(func() string { return "queued" })
+
+	<-started
+	bounded.Stop()
+
+	bounded.Go(func() string { return "submitted after stop" })
+
+	if results := bounded.Wait(); len(results) != 1 || results[0] != "wrapped up" {
+		t.Fatalf("expected only the running job to wrap up, got %v", results)
+	}
+}
+
+func TestBounded_CancelCancelsJobContext(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[error](context.TODO(), 1)
+	started := make(chan struct{})
+
+	bounded.Go(func() error {
+		close(started)
+		<-bounded.Context().Done()
+
+		return bounded.Context().Err()
+	})
+	bounded.Go(func() error { return nil })
+
+	<-started
+	bounded.Cancel()
+
+	if results := bounded.Wait(); len(results) != 1 || results[0] == nil {
+		t.Fatalf("expected only the running job to see the cancellation, got %v", results)
+	}
+}
+
+func TestBounded_ParentContextStops(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	bounded := nursery.NewBounded[int](ctx, 1)
+
+	cancel()
+	<-bounded.Stopping()
+
+	bounded.Wait()
+}
+
+func TestBounded_Token(t *testing.T) {
+	t.Parallel()
+
+	for name, test := range map[string]struct {
+		cancel func(bounded *nursery.Bounded[int], cancelCtx context.CancelCauseFunc)
+		reason error
+	}{
+		"stop":    {func(bounded *nursery.Bounded[int], _ context.CancelCauseFunc) { bounded.Stop() }, nursery.ErrStopped},
+		"cancel":  {func(bounded *nursery.Bounded[int], _ context.CancelCauseFunc) { bounded.Cancel() }, context.Canceled},
+		"context": {func(_ *nursery.Bounded[int], cancelCtx context.CancelCauseFunc) { cancelCtx(io.EOF) }, io.EOF},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancelCtx := context.WithCancelCause(context.TODO())
+			defer cancelCtx(nil)
+
+			bounded := nursery.NewBounded[int](ctx, 1)
+			token := bounded.Token()
+
+			if token.Cancelled() || token.Reason() != nil {
+				t.Fatal("expected the token not to be cancelled yet")
+			}
+
+			test.cancel(bounded, cancelCtx)
+			<-bounded.Stopping()
+
+			for !token.Cancelled() {
+				time.Sleep(time.Microsecond)
+			}
+
+			if !errors.Is(token.Reason(), test.reason) {
+				t.Fatalf("expected %v as reason, got %v", test.reason, token.Reason())
+			}
+
+			bounded.Wait()
+		})
+	}
+}
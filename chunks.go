@@ -0,0 +1,79 @@
+package nursery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Chunk is a byte range of the input of [ProcessChunks].
+// Index is the position of the chunk, Offset the position of its first byte.
+type Chunk struct {
+	Index  int
+	Offset int64
+	*io.SectionReader
+}
+
+// ProcessChunks splits the first size bytes of r into chunks of chunkSize bytes, the last one may be shorter,
+// and calls fn for each chunk on a [Bounded] nursery running at most n calls in parallel,
+// e.g. to hash, compress or upload parts of a large file.
+// The results are returned in the order of the chunks, errors are joined.
+// Once ctx is done, chunks that were not processed yet are dropped and its cause is returned.
+//
+//nolint:varnamelen // n is perfectly fine
+func ProcessChunks[R any](
+	ctx context.Context,
+	n int,
+	r io.ReaderAt,
+	size, chunkSize int64,
+	fn func(ctx context.Context, chunk Chunk) (R, error),
+) ([]R, error) {
+	if fn == nil {
+		panic("fn must not be nil")
+	}
+
+	if size < 0 {
+		panic(fmt.Sprintf("size must not be negative, but was %d", size))
+	}
+
+	if chunkSize < 1 {
+		panic(fmt.Sprintf("chunk size must be at least 1, but was %d", chunkSize))
+	}
+
+	chunks := int((size + chunkSize - 1) / chunkSize)
+	results := make([]R, chunks)
+	started := make([]bool, chunks)
+
+	errs := WithBounded(ctx, n, func(Go Go[error]) {
+		for index := range chunks {
+			offset := int64(index) * chunkSize
+			chunk := Chunk{
+				Index:         index,
+				Offset:        offset,
+				SectionReader: io.NewSectionReader(r, offset, min(chunkSize, size-offset)),
+			}
+
+			Go(func() error {
+				started[index] = true
+
+				result, err := fn(ctx, chunk)
+				if err != nil {
+					return fmt.Errorf("chunk %d: %w", index, err)
+				}
+
+				results[index] = result
+
+				return nil
+			})
+		}
+	})
+
+	// Chunks are only dropped, once the context is done.
+	if slices.Contains(started, false) {
+		errs = append(errs, context.Cause(ctx))
+	}
+
+	return results, errors.Join(errs...)
+}
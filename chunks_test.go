@@ -0,0 +1,79 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestProcessChunks_OrderedResults(t *testing.T) {
+	t.Parallel()
+
+	input := "abcdefghij"
+
+	chunks, err := nursery.ProcessChunks(context.TODO(), 2, strings.NewReader(input), int64(len(input)), 3,
+		func(_ context.Context, chunk nursery.Chunk) (string, error) {
+			data, err := io.ReadAll(chunk)
+
+			return string(data), err
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"abc", "def", "ghi", "j"}; !slices.Equal(chunks, want) {
+		t.Fatalf("expected %v, got %v", want, chunks)
+	}
+}
+
+func TestProcessChunks_JoinsErrors(t *testing.T) {
+	t.Parallel()
+
+	errOdd := errors.New("odd")
+
+	_, err := nursery.ProcessChunks(context.TODO(), 2, strings.NewReader("abcd"), 4, 1,
+		func(_ context.Context, chunk nursery.Chunk) (int, error) {
+			if chunk.Index%2 == 1 {
+				return 0, errOdd
+			}
+
+			return chunk.Index, nil
+		})
+
+	if !errors.Is(err, errOdd) || strings.Count(err.Error(), "odd") != 2 {
+		t.Fatalf("expected the errors of both odd chunks, got %v", err)
+	}
+}
+
+func TestProcessChunks_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	_, err := nursery.ProcessChunks(ctx, 1, strings.NewReader("abcd"), 4, 1,
+		func(context.Context, nursery.Chunk) (int, error) {
+			return 0, nil
+		})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the processing to be cancelled, got %v", err)
+	}
+}
+
+func TestProcessChunks_NegativeSize(t *testing.T) {
+	t.Parallel()
+
+	got := recovered(func() {
+		_, _ = nursery.ProcessChunks(context.TODO(), 1, strings.NewReader(""), -1, 1,
+			func(context.Context, nursery.Chunk) (int, error) { return 0, nil })
+	})
+	if got != "size must not be negative, but was -1" {
+		t.Fatalf("expected a negative size to panic, got %v", got)
+	}
+}
@@ -0,0 +1,86 @@
+package nursery
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+)
+
+// collect sends the result of a job to the collector, unless a hook discards it.
+func (nursery *Unbounded[R]) collect(job JobInfo, result R) {
+	if nursery.void {
+		return
+	}
+
+	if nursery.wrapErrors {
+		result = mapError(result, func(err error) error {
+			return fmt.Errorf("%s: %w", job, err)
+		})
+	}
+
+	if nursery.collectErrors {
+		nursery.failures.add(nursery.failure(job, result))
+	}
+
+	if nursery.validate != nil {
+		var ok bool
+
+		result, ok = nursery.validate(result)
+		if !ok {
+			return
+		}
+	}
+
+	nursery.collected.Add(1)
+
+	if nursery.sample < 1 && rand.Float64() >= nursery.sample { //nolint:gosec // sampling needs no secure randomness
+		return
+	}
+
+	nursery.resultC <- indexed[R]{index: job.Index, result: result, failure: nursery.failure(job, result)}
+}
+
+// indexed is a result, together with the index of its job, see [JobInfo],
+// and the error it reports, wrapped with the identity of the job, see [Unbounded.WaitErr].
+type indexed[R any] struct {
+	index   int
+	result  R
+	failure error
+}
+
+// reorder orders the results by the indices of their jobs, see [WithSubmissionOrder].
+func (nursery *Unbounded[R]) reorder() {
+	results := make([]indexed[R], len(nursery.results))
+	for i, result := range nursery.results {
+		results[i] = indexed[R]{index: nursery.indices[i], result: result, failure: nil}
+	}
+
+	slices.SortFunc(results, func(a, b indexed[R]) int {
+		return cmp.Compare(a.index, b.index)
+	})
+
+	for i, result := range results {
+		nursery.results[i] = result.result
+	}
+}
+
+// mapError replaces the error reported by the result, if there is one.
+// Results report errors, if they are an error, or a tuple with an error as last component,
+// like a [Tuple], [Tuple5] or [Result].
+func mapError[R any](result R, mapping func(error) error) R {
+	switch typed := any(result).(type) {
+	case error:
+		if mapped, ok := mapping(typed).(R); ok {
+			return mapped
+		}
+	case interface {
+		mapError(mapping func(error) error) any
+	}:
+		if mapped, ok := typed.mapError(mapping).(R); ok {
+			return mapped
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,173 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+var errOdd = errors.New("odd")
+
+func rejectOdd(result int) error {
+	if result%2 != 0 {
+		return errOdd
+	}
+
+	return nil
+}
+
+func TestWithValidator_DropsInvalid(t *testing.T) {
+	t.Parallel()
+
+	results := nursery.WithUnbounded(func(Go nursery.Go[int]) {
+		for job := range 6 {
+			Go(func() int { return job })
+		}
+	}, nursery.WithValidator(rejectOdd, nil))
+
+	slices.Sort(results)
+
+	if !slices.Equal(results, []int{0, 2, 4}) {
+		t.Fatalf("expected only even results, got %v", results)
+	}
+}
+
+func TestWithValidator_ConvertsInvalid(t *testing.T) {
+	t.Parallel()
+
+	type result = nursery.Tuple[int, error]
+
+	validate := func(result result) error {
+		return rejectOdd(result.First)
+	}
+
+	convert := func(invalid result, err error) result {
+		return nursery.NewTuple(invalid.First, err)
+	}
+
+	results := nursery.WithBounded(context.TODO(), 2, func(Go nursery.Go[result]) {
+		for job := range 4 {
+			Go(func() result { return nursery.NewTuple[int, error](job, nil) })
+		}
+	}, nursery.WithValidator(validate, convert))
+
+	for _, result := range results {
+		if (result.First%2 != 0) != errors.Is(result.Second, errOdd) {
+			t.Fatalf("expected exactly odd results to carry the error, got %v", results)
+		}
+	}
+}
+
+func TestWithValidator_MismatchingTypePanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected validator for a different result type to panic")
+		}
+	}()
+
+	nursery.NewUnbounded[string](nursery.WithValidator(rejectOdd, nil))
+}
+
+func TestWithFinalizer_AppliesOnce(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	sorted := func(results []int) []int {
+		calls++
+
+		slices.Sort(results)
+
+		return results
+	}
+
+	unbounded := nursery.NewUnbounded[int](nursery.WithFinalizer(sorted))
+
+	for job := range 8 {
+		unbounded.Go(func() int { return 7 - job })
+	}
+
+	unbounded.Wait()
+
+	if results := unbounded.Wait(); !slices.Equal(results, []int{0, 1, 2, 3, 4, 5, 6, 7}) || calls != 1 {
+		t.Fatalf("expected results to be sorted once, got %v after %d calls", results, calls)
+	}
+}
+
+func TestWithFinalizer_ShardedAppliesToMergedResults(t *testing.T) {
+	t.Parallel()
+
+	truncated := func(results []int) []int {
+		return results[:min(len(results), 3)]
+	}
+
+	sharded := nursery.NewSharded[int](4, nursery.WithFinalizer(truncated))
+
+	for job := range 8 {
+		sharded.Go(func() int { return job })
+	}
+
+	if results := sharded.Wait(); len(results) != 3 {
+		t.Fatalf("expected merged results to be truncated, got %v", results)
+	}
+}
+
+func TestWithDedup(t *testing.T) {
+	t.Parallel()
+
+	results := nursery.WithUnbounded(func(Go nursery.Go[string]) {
+		for _, item := range []string{"a", "A", "b", "a"} {
+			Go(func() string { return item })
+		}
+	}, nursery.WithDedup(strings.ToLower), nursery.WithFinalizer(func(results []string) []string {
+		slices.Sort(results)
+
+		return results
+	}))
+
+	if len(results) != 2 || !strings.EqualFold(results[0], "a") || results[1] != "b" {
+		t.Fatalf("expected a single a and b, got %v", results)
+	}
+}
+
+func TestWithDedup_Sharded(t *testing.T) {
+	t.Parallel()
+
+	sharded := nursery.NewSharded[int](4, nursery.WithDedup(func(result int) int { return result }))
+
+	for range 8 {
+		sharded.Go(func() int { return 1 })
+	}
+
+	if results := sharded.Wait(); len(results) != 1 {
+		t.Fatalf("expected duplicates across shards to collapse, got %v", results)
+	}
+}
+
+func TestWithSample(t *testing.T) {
+	t.Parallel()
+
+	const jobs = 1000
+
+	unbounded := nursery.NewUnbounded[int](nursery.WithSample(0.1))
+
+	for job := range jobs {
+		unbounded.Go(func() int { return job })
+	}
+
+	results := unbounded.Wait()
+
+	// The probability to retain more than a third of the results is negligible.
+	if len(results) == 0 || len(results) > jobs/3 {
+		t.Fatalf("expected a sample of the results, got %d", len(results))
+	}
+
+	if stats := unbounded.Stats(); stats.Results != jobs {
+		t.Fatalf("expected all results to be counted, got %+v", stats)
+	}
+}
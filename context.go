@@ -0,0 +1,14 @@
+package nursery
+
+import "context"
+
+// GoContext is like [Bounded.Go], but hands the nursery's context to the job, see [Bounded.Context],
+// so the job is scoped to the nursery instead of closing over an external context:
+// it is cancelled, once the parent context is done, the nursery is cancelled, or Wait returns.
+func (nursery *Bounded[R]) GoContext(job func(ctx context.Context) R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.submit(nursery.slots, JobInfo{Index: 0, Tag: "", Name: ""}, func(*Attempt) R { return job(nursery.ctx) })
+}
@@ -0,0 +1,40 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestGoContext_ScopedToNursery(t *testing.T) {
+	t.Parallel()
+
+	parent, cancel := context.WithCancelCause(context.TODO())
+	bounded := nursery.NewBounded[error](parent, 1)
+
+	bounded.GoContext(func(ctx context.Context) error {
+		cancel(errTransient)
+		<-ctx.Done()
+
+		return context.Cause(ctx)
+	})
+
+	if results := bounded.Wait(); len(results) != 1 || !errors.Is(results[0], errTransient) {
+		t.Fatalf("expected the job's context to be cancelled with the parent's, got %v", results)
+	}
+}
+
+func TestGoContext_CancelledByWait(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[context.Context](context.TODO(), 1)
+	bounded.GoContext(func(ctx context.Context) context.Context { return ctx })
+
+	results := bounded.Wait()
+
+	if len(results) != 1 || results[0].Err() == nil {
+		t.Fatalf("expected the job's context to be cancelled once Wait returned, got %v", results)
+	}
+}
@@ -0,0 +1,108 @@
+package nursery
+
+import (
+	"fmt"
+	"time"
+)
+
+// DecisionKind is the kind of a scheduling [Decision].
+type DecisionKind int
+
+const (
+	// DecisionWait is logged, once a job has to wait for a slot, with the reason why.
+	DecisionWait DecisionKind = iota
+	// DecisionSlot is logged, once a job got a slot, either a free one, or handed over by a finished job.
+	DecisionSlot
+	// DecisionLimit is logged, once the number of slots changed, e.g. while ramping up, see [WithRampUp].
+	DecisionLimit
+)
+
+func (kind DecisionKind) String() string {
+	switch kind {
+	case DecisionWait:
+		return "wait"
+	case DecisionSlot:
+		return "slot"
+	case DecisionLimit:
+		return "limit"
+	default:
+		return "unknown"
+	}
+}
+
+// Decision is a decision of the scheduler of a [Bounded] nursery, see [WithSchedulingLog].
+// Job is the job the decision is about, and zero for limit changes.
+// Lane is the slot the job got, or -1, Limit the number of slots once the decision was made.
+// Bulkhead names the bulkhead, whose slots the decision is about, see [WithBulkheads].
+type Decision struct {
+	Kind     DecisionKind
+	Job      JobInfo
+	Lane     int
+	Limit    int
+	Bulkhead string
+	Reason   string
+	Time     time.Time
+}
+
+// String describes the decision, e.g. job 3 (tag "db") waits: all 2 slots busy.
+func (decision Decision) String() string {
+	switch decision.Kind {
+	case DecisionWait:
+		return fmt.Sprintf("%s waits: %s", decision.Job, decision.Reason)
+	case DecisionSlot:
+		return fmt.Sprintf("%s got slot %d: %s", decision.Job, decision.Lane, decision.Reason)
+	default:
+		return fmt.Sprintf("%s to %d", decision.Reason, decision.Limit)
+	}
+}
+
+// SchedulingLog returns the most recent scheduling decisions, oldest first,
+// or nil, if the nursery was not created with [WithSchedulingLog].
+func (nursery *Bounded[R]) SchedulingLog() []Decision {
+	if nursery.decisions == nil {
+		return nil
+	}
+
+	return nursery.decisions.snapshot()
+}
+
+// decide logs the decision, if decisions are logged.
+// It must be called while holding the lock of the slots.
+func (s *slots) decide(kind DecisionKind, job JobInfo, lane int, reason string) {
+	if s.decisions == nil {
+		return
+	}
+
+	s.decisions.record(Decision{
+		Kind:     kind,
+		Job:      job,
+		Lane:     lane,
+		Limit:    s.limit,
+		Bulkhead: s.bulkhead,
+		Reason:   reason,
+		Time:     time.Now(),
+	})
+}
+
+// blocker describes, why the queued waiter did not get a slot.
+// It must be called while holding the lock of the slots.
+func (s *slots) blocker(waiter *waiter) string {
+	switch {
+	case s.isolated:
+		return "exclusive job running"
+	case waiter.access == accessExclusive && s.used > 0:
+		return fmt.Sprintf("exclusive job waits for %d running jobs", s.used)
+	case s.used >= s.limit:
+		return fmt.Sprintf("all %d slots busy", s.limit)
+	case waiter.access != accessExclusive && s.exclusive.Len() > 0:
+		return "exclusive job waiting"
+	case s.writing:
+		return "writer running"
+	case waiter.access == accessWrite && s.readers > 0:
+		return fmt.Sprintf("%d readers running", s.readers)
+	case waiter.access == accessRead:
+		return "writer waiting"
+	default:
+		return "queued behind other jobs"
+	}
+}
@@ -0,0 +1,71 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithSchedulingLog(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithSchedulingLog(16))
+
+	release := make(chan struct{})
+
+	bounded.Go(func() int {
+		<-release
+
+		return 0
+	})
+	bounded.GoNamed("second", func() int { return 1 })
+	close(release)
+	bounded.Wait()
+
+	descriptions := []string{}
+	for _, decision := range bounded.SchedulingLog() {
+		descriptions = append(descriptions, decision.String())
+	}
+
+	want := []string{
+		"job 0 got slot 0: slot was free",
+		`job "second" waits: all 1 slots busy`,
+		`job "second" got slot 0: handed over by job 0`,
+	}
+
+	if !slices.Equal(descriptions, want) {
+		t.Fatalf("expected %q, got %q", want, descriptions)
+	}
+}
+
+func TestWithSchedulingLog_Exclusive(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 2, nursery.WithSchedulingLog(16))
+
+	release := make(chan struct{})
+
+	bounded.Go(func() int {
+		<-release
+
+		return 0
+	})
+	bounded.RunExclusive(func() int { return 1 })
+	bounded.Go(func() int { return 2 })
+	close(release)
+	bounded.Wait()
+
+	reasons := map[int]string{}
+
+	for _, decision := range bounded.SchedulingLog() {
+		if decision.Kind == nursery.DecisionWait {
+			reasons[decision.Job.Index] = decision.Reason
+		}
+	}
+
+	if reasons[1] != "exclusive job waits for 1 running jobs" || reasons[2] != "exclusive job waiting" {
+		t.Fatalf("expected the exclusive job to hold back the others, got %v", reasons)
+	}
+}
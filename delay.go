@@ -0,0 +1,31 @@
+package nursery
+
+import "time"
+
+// GoAfterDelay is like [Unbounded.Go], but starts the job once the delay passed,
+// so simple scheduled work stays in the nursery instead of an ad-hoc [time.AfterFunc].
+func (nursery *Unbounded[R]) GoAfterDelay(delay time.Duration, job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.init()
+	nursery.mx.RLock()
+	defer nursery.mx.RUnlock()
+
+	if nursery.closed {
+		panic(ErrClosed)
+	}
+
+	nursery.spawnAfter(delay, JobInfo{Index: 0, Tag: "", Name: ""}, func(JobInfo) R { return job() })
+}
+
+// GoAfterDelay is like [Bounded.Go], but the job waits for the delay to pass, before it waits for a slot.
+// It does not occupy a slot while waiting, and is skipped, if the nursery is stopped in the meantime.
+func (nursery *Bounded[R]) GoAfterDelay(delay time.Duration, job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.submitAccess(accessShared, delay, job)
+}
@@ -0,0 +1,54 @@
+package nursery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestGoAfterDelay(t *testing.T) {
+	t.Parallel()
+
+	for name, newNursery := range map[string]func() delayer{
+		"unbounded": func() delayer { return nursery.NewUnbounded[time.Time]() },
+		"bounded":   func() delayer { return nursery.NewBounded[time.Time](context.TODO(), 1) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start := time.Now()
+			delayer := newNursery()
+
+			delayer.GoAfterDelay(5*time.Millisecond, time.Now)
+			delayer.Go(time.Now)
+
+			results := delayer.Wait()
+			if len(results) != 2 || results[0].Sub(start) >= 5*time.Millisecond || results[1].Sub(start) < 5*time.Millisecond {
+				t.Fatalf("expected the delayed job to start after the delay, got %v", results)
+			}
+		})
+	}
+}
+
+func TestBounded_GoAfterDelaySkippedOnCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	bounded := nursery.NewBounded[int](ctx, 1)
+	bounded.GoAfterDelay(time.Hour, func() int { return 1 })
+
+	cancel()
+
+	if results := bounded.Wait(); len(results) != 0 {
+		t.Fatalf("expected the delayed job to be skipped, got %v", results)
+	}
+}
+
+// delayer is a nursery, whose jobs report when they started.
+type delayer interface {
+	clock
+	GoAfterDelay(delay time.Duration, job func() time.Time)
+}
@@ -0,0 +1,71 @@
+package nursery
+
+import (
+	"sync"
+	"time"
+)
+
+// delays runs functions after a delay, without occupying a goroutine while waiting.
+type delays struct {
+	mx      sync.Mutex
+	closed  bool
+	pending map[*time.Timer]func()
+}
+
+func newDelays() *delays {
+	return &delays{
+		mx:      sync.Mutex{},
+		closed:  false,
+		pending: map[*time.Timer]func(){},
+	}
+}
+
+// after calls fire once the delay passed, or skip if the delays are closed before.
+func (delays *delays) after(delay time.Duration, fire, skip func()) {
+	delays.mx.Lock()
+
+	if delays.closed {
+		delays.mx.Unlock()
+		skip()
+
+		return
+	}
+
+	defer delays.mx.Unlock()
+
+	var timer *time.Timer
+
+	timer = time.AfterFunc(delay, func() {
+		delays.mx.Lock()
+		delete(delays.pending, timer)
+		delays.mx.Unlock()
+
+		fire()
+	})
+
+	delays.pending[timer] = skip
+}
+
+// close skips all pending functions, as well as all future ones.
+func (delays *delays) close() {
+	delays.mx.Lock()
+
+	delays.closed = true
+
+	var skipped []func()
+
+	for timer, skip := range delays.pending {
+		// Timers that already fired, remove themselves.
+		if timer.Stop() {
+			delete(delays.pending, timer)
+
+			skipped = append(skipped, skip)
+		}
+	}
+
+	delays.mx.Unlock()
+
+	for _, skip := range skipped {
+		skip()
+	}
+}
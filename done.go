@@ -0,0 +1,87 @@
+package nursery
+
+import "sync"
+
+// completion closes a channel, once the wait it started returned, see [Unbounded.Done].
+type completion struct {
+	started sync.Once
+	done    chan struct{}
+}
+
+func newCompletion() *completion {
+	return &completion{started: sync.Once{}, done: make(chan struct{})}
+}
+
+// start runs wait in the background on the first call, and returns the channel closed once it returned.
+func (completion *completion) start(wait func()) <-chan struct{} {
+	completion.started.Do(func() {
+		go func() {
+			defer close(completion.done)
+
+			wait()
+		}()
+	})
+
+	return completion.done
+}
+
+// Done returns a channel, that is closed once all jobs are finished and their results are collected,
+// so Wait returns them right away, e.g. to select on the completion of the nursery alongside other events.
+// Like Wait, it makes the nursery reject new jobs.
+// Panics of jobs are only rethrown by Wait.
+func (nursery *Unbounded[R]) Done() <-chan struct{} {
+	nursery.init()
+
+	return nursery.completion.start(func() { nursery.wait() })
+}
+
+// Done is like [Unbounded.Done].
+func (nursery *Bounded[R]) Done() <-chan struct{} {
+	return nursery.completion.start(func() { nursery.wait() })
+}
+
+// Done is like [Unbounded.Done].
+func (nursery *Sharded[R]) Done() <-chan struct{} {
+	return nursery.completion.start(func() { nursery.wait() })
+}
+
+// TryWait is like Wait, but does not block:
+// it returns the results, if all jobs are finished already, or false otherwise, e.g. for callers polling the nursery.
+// Like Wait, it makes the nursery reject new jobs.
+func (nursery *Unbounded[R]) TryWait() ([]R, bool) {
+	nursery.close()
+
+	// Once the nursery is closed, only running jobs can start new ones.
+	if nursery.active.Load() > 0 {
+		return nil, false
+	}
+
+	return nursery.Wait(), true
+}
+
+// TryWait is like [Unbounded.TryWait].
+// With [WithInline], it reports false as long as jobs are left, as they only run in Wait.
+func (nursery *Bounded[R]) TryWait() ([]R, bool) {
+	nursery.inner.close()
+
+	if nursery.inner.active.Load() > 0 {
+		return nil, false
+	}
+
+	return nursery.Wait(), true
+}
+
+// TryWait is like [Unbounded.TryWait].
+func (nursery *Sharded[R]) TryWait() ([]R, bool) {
+	for _, shard := range nursery.shards {
+		shard.close()
+	}
+
+	for _, shard := range nursery.shards {
+		if shard.active.Load() > 0 {
+			return nil, false
+		}
+	}
+
+	return nursery.Wait(), true
+}
@@ -0,0 +1,113 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+type completer interface {
+	nursery.Source[int]
+	Go(job func() int)
+	Done() <-chan struct{}
+	TryWait() ([]int, bool)
+}
+
+func TestDone_ClosedOnceJobsFinished(t *testing.T) {
+	t.Parallel()
+
+	for name, newNursery := range map[string]func() completer{
+		"Unbounded": func() completer { return nursery.NewUnbounded[int]() },
+		"Bounded":   func() completer { return nursery.NewBounded[int](context.TODO(), 1) },
+		"Sharded":   func() completer { return nursery.NewSharded[int](2) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			completer := newNursery()
+			release := make(chan struct{})
+
+			completer.Go(func() int {
+				<-release
+
+				return 1
+			})
+			completer.Go(func() int { return 2 })
+
+			done := completer.Done()
+
+			select {
+			case <-done:
+				t.Fatal("expected Done to wait for the running job")
+			case <-time.After(time.Millisecond):
+			}
+
+			close(release)
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("expected Done to be closed once all jobs finished")
+			}
+
+			results := completer.Wait()
+			slices.Sort(results)
+
+			if !slices.Equal(results, []int{1, 2}) || completer.Done() != done {
+				t.Fatalf("expected Wait to return the results right away, got %v", results)
+			}
+		})
+	}
+}
+
+func TestDone_RejectsNewJobs(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+	<-unbounded.Done()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected starting a job after Done to panic")
+		}
+	}()
+
+	unbounded.Go(func() int { return 1 })
+}
+
+func TestTryWait(t *testing.T) {
+	t.Parallel()
+
+	for name, newNursery := range map[string]func() completer{
+		"Unbounded": func() completer { return nursery.NewUnbounded[int]() },
+		"Bounded":   func() completer { return nursery.NewBounded[int](context.TODO(), 1) },
+		"Sharded":   func() completer { return nursery.NewSharded[int](2) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			completer := newNursery()
+			release := make(chan struct{})
+
+			completer.Go(func() int {
+				<-release
+
+				return 1
+			})
+
+			if results, ok := completer.TryWait(); ok {
+				t.Fatalf("expected TryWait to report the running job, got %v", results)
+			}
+
+			close(release)
+			<-completer.Done()
+
+			if results, ok := completer.TryWait(); !ok || !slices.Equal(results, []int{1}) {
+				t.Fatalf("expected TryWait to return the results, got %v", results)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package nursery
+
+import "errors"
+
+var (
+	// ErrClosed is reported, once a job is submitted to a nursery, that is waited for already.
+	// Submitting a job via Go panics with it.
+	ErrClosed = errors.New("nursery is closed")
+	// ErrAborted is reported, once a job is submitted to a nursery, that was stopped or cancelled,
+	// see [Bounded.Stop] and [Bounded.Cancel].
+	ErrAborted = errors.New("nursery is aborted")
+	// ErrQueueFull is reported, once a job is submitted to a nursery, whose queue of waiting jobs is full,
+	// see [WithQueueLimit].
+	ErrQueueFull = errors.New("queue is full")
+	// ErrBoundInvalid is reported for a bound less than 1. Constructors panic with it.
+	ErrBoundInvalid = errors.New("bound must be at least 1")
+	// ErrSkipped is reported for a job, that never ran, as the nursery was stopped before the job got a slot,
+	// or it was cancelled, see [Future.Err].
+	ErrSkipped = errors.New("job was skipped")
+)
@@ -0,0 +1,70 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestErrClosed(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+	unbounded.Wait()
+
+	err, _ := recovered(func() { unbounded.Go(func() int { return 0 }) }).(error)
+	if !errors.Is(err, nursery.ErrClosed) {
+		t.Fatalf("expected submitting to a closed nursery to panic with ErrClosed, got %v", err)
+	}
+}
+
+func TestErrBoundInvalid(t *testing.T) {
+	t.Parallel()
+
+	err, _ := recovered(func() { nursery.NewBounded[int](context.TODO(), 0) }).(error)
+	if !errors.Is(err, nursery.ErrBoundInvalid) || err.Error() != "bound must be at least 1, but was 0" {
+		t.Fatalf("expected an invalid bound to panic with ErrBoundInvalid, got %v", err)
+	}
+}
+
+func TestErrAborted_TryGo(t *testing.T) {
+	t.Parallel()
+
+	for name, abort := range map[string]func(*nursery.Bounded[int]){
+		"stop":   (*nursery.Bounded[int]).Stop,
+		"cancel": (*nursery.Bounded[int]).Cancel,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bounded := nursery.NewBoundedSimple[int](1)
+			abort(bounded)
+
+			if err := bounded.TryGo(func() int { return 1 }); !errors.Is(err, nursery.ErrAborted) {
+				t.Fatalf("expected submitting to an aborted nursery to report ErrAborted, got %v", err)
+			}
+
+			if results := bounded.Wait(); len(results) != 0 {
+				t.Fatalf("expected the job to be dropped, got %v", results)
+			}
+		})
+	}
+}
+
+func TestErrAborted_Go(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBoundedSimple[int](1)
+	bounded.Stop()
+
+	// Go drops the job of an aborted nursery, instead of panicking.
+	if err, _ := recovered(func() { bounded.Go(func() int { return 1 }) }).(error); err != nil {
+		t.Fatalf("expected the job to be dropped without a panic, got %v", err)
+	}
+
+	if results := bounded.Wait(); len(results) != 0 || bounded.Skipped() != 1 {
+		t.Fatalf("expected the job to be skipped, got %v and %d", results, bounded.Skipped())
+	}
+}
@@ -0,0 +1,43 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithCostEstimator_SkipsJobsThatCannotFinish(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	estimates := map[string]time.Duration{"quick": time.Second, "slow": time.Hour}
+
+	bounded := nursery.NewBounded[string](ctx, 1, nursery.WithCostEstimator(func(job nursery.JobInfo) time.Duration {
+		return estimates[job.Tag]
+	}))
+
+	for tag := range estimates {
+		bounded.GoTagged(tag, func() string { return tag })
+	}
+
+	if results := bounded.Wait(); !slices.Equal(results, []string{"quick"}) {
+		t.Fatalf("expected only the job fitting the deadline to run, got %v", results)
+	}
+}
+
+func TestWithCostEstimator_WithoutDeadline(t *testing.T) {
+	t.Parallel()
+
+	results := nursery.WithBounded(context.TODO(), 1, func(Go nursery.Go[int]) {
+		Go(func() int { return 1 })
+	}, nursery.WithCostEstimator(func(nursery.JobInfo) time.Duration { return time.Hour }))
+
+	if len(results) != 1 {
+		t.Fatalf("expected the job to run without a deadline, got %v", results)
+	}
+}
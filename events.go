@@ -0,0 +1,116 @@
+package nursery
+
+import (
+	"sync"
+	"time"
+)
+
+// EventKind is the kind of a lifecycle [Event] of a job.
+type EventKind int
+
+const (
+	// EventSubmit is recorded, once a job was handed to the nursery.
+	EventSubmit EventKind = iota
+	// EventStart is recorded, once a job starts running; [Bounded] nurseries record it once per attempt.
+	EventStart
+	// EventFinish is recorded, once a job returned and is not requeued anymore.
+	EventFinish
+	// EventSkip is recorded, once a scheduled job is dropped, as the nursery's context is done.
+	EventSkip
+	// EventExit is recorded, once a job called [runtime.Goexit] instead of returning, e.g. via [testing.T.FailNow].
+	// The job is finished without a result.
+	EventExit
+	// EventPanic is recorded, once a job panicked. The job is finished without a result, and Wait rethrows the panic.
+	EventPanic
+)
+
+func (kind EventKind) String() string {
+	switch kind {
+	case EventSubmit:
+		return "submit"
+	case EventStart:
+		return "start"
+	case EventFinish:
+		return "finish"
+	case EventSkip:
+		return "skip"
+	case EventExit:
+		return "exit"
+	case EventPanic:
+		return "panic"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a lifecycle event of a job, see [WithEvents].
+// Job is the index of the job, see [JobInfo].
+type Event struct {
+	Kind EventKind
+	Job  int
+	Tag  string
+	Name string
+	Time time.Time
+}
+
+// ring keeps the most recent entries, e.g. events, overwriting the oldest ones.
+type ring[T any] struct {
+	mx      sync.Mutex
+	entries []T
+	// next is the index the next entry is written to.
+	next int
+	full bool
+}
+
+func newRing[T any](size int) *ring[T] {
+	return &ring[T]{mx: sync.Mutex{}, entries: make([]T, size), next: 0, full: false}
+}
+
+func (ring *ring[T]) record(entry T) {
+	ring.mx.Lock()
+	defer ring.mx.Unlock()
+
+	ring.entries[ring.next] = entry
+
+	ring.next = (ring.next + 1) % len(ring.entries)
+	if ring.next == 0 {
+		ring.full = true
+	}
+}
+
+// snapshot returns the recorded entries, oldest first.
+func (ring *ring[T]) snapshot() []T {
+	ring.mx.Lock()
+	defer ring.mx.Unlock()
+
+	if !ring.full {
+		return append([]T(nil), ring.entries[:ring.next]...)
+	}
+
+	return append(append([]T(nil), ring.entries[ring.next:]...), ring.entries[:ring.next]...)
+}
+
+// record adds an event for the job, if the nursery keeps events.
+func (nursery *Unbounded[R]) record(kind EventKind, job JobInfo) {
+	if nursery.events == nil {
+		return
+	}
+
+	nursery.events.record(Event{Kind: kind, Job: job.Index, Tag: job.Tag, Name: job.Name, Time: time.Now()})
+}
+
+// Events returns the most recent lifecycle events of the nursery's jobs, oldest first,
+// or nil, if the nursery was not created with [WithEvents].
+func (nursery *Unbounded[R]) Events() []Event {
+	if nursery.events == nil {
+		return nil
+	}
+
+	return nursery.events.snapshot()
+}
+
+// Events returns the most recent lifecycle events of the nursery's jobs, oldest first,
+// or nil, if the nursery was not created with [WithEvents].
+func (nursery *Bounded[R]) Events() []Event {
+	return nursery.inner.Events()
+}
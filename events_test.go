@@ -0,0 +1,92 @@
+package nursery_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithEvents_RecordsLifecycle(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithEvents(16))
+	bounded.GoTagged("a", func() int { return 1 })
+	bounded.Wait()
+
+	kinds := []nursery.EventKind{}
+	for _, event := range bounded.Events() {
+		if event.Job != 0 || event.Tag != "a" || event.Time.IsZero() {
+			t.Fatalf("expected events of the tagged job, got %+v", event)
+		}
+
+		kinds = append(kinds, event.Kind)
+	}
+
+	want := []nursery.EventKind{nursery.EventSubmit, nursery.EventStart, nursery.EventFinish}
+	if len(kinds) != len(want) || kinds[0] != want[0] || kinds[1] != want[1] || kinds[2] != want[2] {
+		t.Fatalf("expected %v, got %v", want, kinds)
+	}
+}
+
+func TestWithEvents_KeepsMostRecent(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int](nursery.WithEvents(3))
+
+	for job := range 5 {
+		unbounded.Go(func() int { return job })
+	}
+
+	unbounded.Wait()
+
+	events := unbounded.Events()
+	if len(events) != 3 {
+		t.Fatalf("expected the ring to hold 3 events, got %v", events)
+	}
+
+	for i := 1; i < len(events); i++ {
+		if events[i].Time.Before(events[i-1].Time) {
+			t.Fatalf("expected events oldest first, got %v", events)
+		}
+	}
+}
+
+func TestWithEvents_RecordsSkippedJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	bounded := nursery.NewBounded[int](ctx, 1, nursery.WithEvents(16))
+
+	bounded.Go(func() int {
+		cancel()
+
+		return 1
+	})
+	bounded.Go(func() int { return 2 })
+	bounded.Wait()
+
+	skipped := 0
+
+	for _, event := range bounded.Events() {
+		if event.Kind == nursery.EventSkip {
+			skipped++
+		}
+	}
+
+	if skipped != 1 {
+		t.Fatalf("expected the second job to be skipped, got %v", bounded.Events())
+	}
+}
+
+func TestEvents_DisabledByDefault(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+	unbounded.Go(func() int { return 1 })
+	unbounded.Wait()
+
+	if events := unbounded.Events(); events != nil {
+		t.Fatalf("expected no events, got %v", events)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/lukasngl/nursery"
@@ -77,3 +78,39 @@ func ExampleWithBounded() {
 	// result= err=timed out :/
 	// result= err=timed out :/
 }
+
+func ExampleUnbounded_GoScoped() {
+	var countdown func(n int) func(Go nursery.Scope[int]) int
+
+	countdown = func(n int) func(Go nursery.Scope[int]) int {
+		return func(Go nursery.Scope[int]) int {
+			if n > 0 {
+				Go(countdown(n - 1))
+			}
+
+			return n
+		}
+	}
+
+	unbounded := nursery.NewUnbounded[int]()
+	unbounded.GoScoped(countdown(3))
+
+	results := unbounded.Wait()
+	slices.Sort(results)
+
+	fmt.Println(results)
+	// Output: [0 1 2 3]
+}
+
+func ExampleSum() {
+	sizes := nursery.WithUnbounded(func(Go nursery.Go[int]) {
+		for _, word := range []string{"structured", "concurrency"} {
+			Go(func() int {
+				return len(word)
+			})
+		}
+	})
+
+	fmt.Println(nursery.Sum(sizes))
+	// Output: 21
+}
@@ -0,0 +1,14 @@
+package nursery
+
+// RunExclusive is like [Bounded.Go], but runs the job alone, e.g. for periodic compaction or checkpoint steps
+// of a long-lived nursery: once it is submitted, no other job is started, until all running jobs finished
+// and the job itself finished, then scheduling resumes.
+// Jobs in bulkheads, see [WithBulkheads], are not affected.
+// The job must not wait for other jobs of the nursery, as they cannot start until it finished.
+func (nursery *Bounded[R]) RunExclusive(job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.submitAccess(accessExclusive, 0, job)
+}
@@ -0,0 +1,74 @@
+package nursery_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBounded_RunExclusive(t *testing.T) {
+	t.Parallel()
+
+	var running, overlaps atomic.Int64
+
+	job := func(exclusive bool) func() int64 {
+		return func() int64 {
+			concurrent := running.Add(1)
+			defer running.Add(-1)
+
+			if exclusive && concurrent != 1 {
+				overlaps.Add(1)
+			}
+
+			time.Sleep(time.Millisecond)
+
+			if exclusive && running.Load() != 1 {
+				overlaps.Add(1)
+			}
+
+			return concurrent
+		}
+	}
+
+	bounded := nursery.NewBounded[int64](context.TODO(), 4)
+
+	for round := range 3 {
+		for range 8 {
+			bounded.Go(job(false))
+		}
+
+		if round > 0 {
+			bounded.RunExclusive(job(true))
+		}
+	}
+
+	if results := bounded.Wait(); len(results) != 26 || overlaps.Load() != 0 {
+		t.Fatalf("expected exclusive jobs to run alone, got %d overlaps in %v", overlaps.Load(), results)
+	}
+}
+
+func TestBounded_RunExclusiveHoldsBackLaterJobs(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[string](context.TODO(), 2)
+	release := make(chan struct{})
+
+	bounded.Go(func() string {
+		<-release
+
+		return "running"
+	})
+
+	bounded.RunExclusive(func() string { return "exclusive" })
+	bounded.Go(func() string { return "later" })
+
+	close(release)
+
+	results := bounded.Wait()
+	if len(results) != 3 || results[0] != "running" || results[1] != "exclusive" || results[2] != "later" {
+		t.Fatalf("expected the later job to wait for the exclusive one, got %v", results)
+	}
+}
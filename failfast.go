@@ -0,0 +1,48 @@
+package nursery
+
+import (
+	"context"
+	"sync"
+)
+
+// WithBoundedErr is like [WithBounded], but for jobs that may fail, similar to errgroup:
+// the first error cancels the nursery like [Bounded.Cancel], with the error as cause of the jobs' context,
+// which is handed to the closure, so jobs can stop early. Queued jobs are dropped once it is cancelled.
+// It returns the first error, once all started jobs are finished.
+func WithBoundedErr(
+	ctx context.Context,
+	n int,
+	run func(ctx context.Context, Go Go[error]),
+	opts ...Option,
+) error {
+	if run == nil {
+		panic("run must not be nil")
+	}
+
+	nursery := NewBounded[error](ctx, n, opts...)
+
+	var (
+		once  sync.Once
+		first error
+	)
+
+	run(nursery.Context(), func(job func() error) {
+		if job == nil {
+			panic(nilJob())
+		}
+
+		nursery.Go(func() error {
+			err := job()
+			if err != nil {
+				once.Do(func() { first = err })
+				nursery.abort(err)
+			}
+
+			return err
+		})
+	})
+
+	nursery.Wait()
+
+	return first
+}
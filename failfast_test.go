@@ -0,0 +1,61 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithBoundedErr_CancelsOnFirstError(t *testing.T) {
+	t.Parallel()
+
+	ran := 0
+
+	err := nursery.WithBoundedErr(context.TODO(), 1, func(ctx context.Context, Go nursery.Go[error]) {
+		Go(func() error {
+			ran++
+
+			return errTransient
+		})
+
+		for range 3 {
+			Go(func() error {
+				ran++
+
+				return nil
+			})
+		}
+
+		<-ctx.Done()
+
+		if !errors.Is(context.Cause(ctx), errTransient) {
+			t.Errorf("expected the error as cause of the context, got %v", context.Cause(ctx))
+		}
+	})
+
+	if !errors.Is(err, errTransient) || ran != 1 {
+		t.Fatalf("expected the first error and the queued jobs to be dropped, got %v after %d jobs", err, ran)
+	}
+}
+
+func TestWithBoundedErr_Succeeds(t *testing.T) {
+	t.Parallel()
+
+	ran := 0
+
+	err := nursery.WithBoundedErr(context.TODO(), 1, func(_ context.Context, Go nursery.Go[error]) {
+		for range 3 {
+			Go(func() error {
+				ran++
+
+				return nil
+			})
+		}
+	})
+
+	if err != nil || ran != 3 {
+		t.Fatalf("expected all jobs to succeed, got %v after %d jobs", err, ran)
+	}
+}
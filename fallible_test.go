@@ -0,0 +1,40 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithUnbounded2_ReturnsErrorOfRun(t *testing.T) {
+	t.Parallel()
+
+	results, err := nursery.WithUnbounded2(func(Go nursery.Go[int]) error {
+		Go(func() int { return 1 })
+
+		return io.EOF
+	})
+
+	if !errors.Is(err, io.EOF) || len(results) != 1 {
+		t.Fatalf("expected the started job and the error, got %v and %v", results, err)
+	}
+}
+
+func TestWithBounded2(t *testing.T) {
+	t.Parallel()
+
+	results, err := nursery.WithBounded2(context.TODO(), 2, func(Go nursery.Go[int]) error {
+		for job := range 3 {
+			Go(func() int { return job })
+		}
+
+		return nil
+	})
+
+	if err != nil || len(results) != 3 {
+		t.Fatalf("expected all results without error, got %v and %v", results, err)
+	}
+}
@@ -0,0 +1,112 @@
+package nursery
+
+import "context"
+
+// Future is a handle to a single job started with [Bounded.GoFuture],
+// so callers can await or cancel it, before the whole nursery is waited for.
+// It is safe for concurrent use.
+type Future[R any] struct {
+	done   chan struct{}
+	result R
+	ok     bool
+	err    error
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+}
+
+// GoFuture is like [Bounded.GoContext], but returns a [Future] of the job.
+// The job is passed its own context, that is derived from the nursery's one and cancelled by [Future.Cancel].
+// Its result is collected by Wait as usual.
+func (nursery *Bounded[R]) GoFuture(job func(ctx context.Context) R) *Future[R] {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	var zero R
+
+	ctx, cancel := context.WithCancelCause(nursery.ctx)
+	future := &Future[R]{done: make(chan struct{}), result: zero, ok: false, err: nil, ctx: ctx, cancel: cancel}
+
+	if err := nursery.reserve(false); err != nil {
+		// The job is dropped, see [QueueDrop], so it counts as skipped.
+		nursery.reject(err)
+		future.resolve(zero, EventSkip)
+
+		return future
+	}
+
+	nursery.inner.mx.RLock()
+	defer nursery.inner.mx.RUnlock()
+
+	if err := nursery.accept(JobInfo{Index: 0, Tag: "", Name: ""}); err != nil {
+		nursery.unreserve()
+		cancel(err)
+		nursery.reject(err)
+		future.resolve(zero, EventSkip)
+
+		return future
+	}
+
+	nursery.schedule(nursery.slots, accessShared, 0, JobInfo{Index: 0, Tag: "", Name: ""}, func(*Attempt) R {
+		return job(ctx)
+	}, future)
+
+	return future
+}
+
+// Done returns a channel, that is closed once the job is finished, or was skipped.
+func (future *Future[R]) Done() <-chan struct{} {
+	return future.done
+}
+
+// Result returns the result of the job, or false if the job is not finished yet, or did not return a result,
+// e.g. as it was skipped or panicked.
+func (future *Future[R]) Result() (R, bool) {
+	select {
+	case <-future.done:
+		return future.result, future.ok
+	default:
+		var zero R
+
+		return zero, false
+	}
+}
+
+// Err returns [ErrSkipped], once the job was skipped, as it was cancelled or the nursery was stopped before it ran,
+// or nil otherwise.
+func (future *Future[R]) Err() error {
+	select {
+	case <-future.done:
+		return future.err
+	default:
+		return nil
+	}
+}
+
+// Cancel cancels the job: it is skipped, if it did not run yet, or its context is cancelled otherwise.
+func (future *Future[R]) Cancel() {
+	future.cancel(context.Canceled)
+}
+
+// cancelled reports whether the future was cancelled, so its job must be skipped.
+func (future *Future[R]) cancelled() bool {
+	return future != nil && future.ctx.Err() != nil
+}
+
+// resolve completes the future with the result of its job, once the job finished with the given event.
+func (future *Future[R]) resolve(result R, kind EventKind) {
+	if future == nil {
+		return
+	}
+
+	switch kind {
+	case EventFinish:
+		future.result, future.ok = result, true
+	case EventSkip:
+		future.err = ErrSkipped
+	case EventSubmit, EventStart, EventPanic, EventExit:
+	}
+
+	future.cancel(context.Canceled)
+	close(future.done)
+}
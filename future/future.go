@@ -0,0 +1,338 @@
+// Package future composes jobs of a nursery promise-style, with the structured cleanup of the nursery:
+// futures, and the jobs continuing them, are owned by a [Group] and joined by [Group.Wait].
+package future
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/lukasngl/nursery"
+)
+
+// ErrAbandoned is the error of a future, whose job did not return, as it panicked or called runtime.Goexit.
+var ErrAbandoned = errors.New("future abandoned")
+
+// Group owns futures, whose jobs run on a bounded nursery.
+// Combinators must be applied before [Group.Wait] is called.
+type Group struct {
+	nursery *nursery.Bounded[struct{}]
+	// mx guards futures, which holds all futures of the group, to skip those that did not resolve, see [Group.Wait].
+	mx      sync.Mutex
+	futures []skipper
+}
+
+// skipper is implemented by futures, to resolve them with [nursery.ErrSkipped].
+type skipper interface {
+	skip()
+}
+
+// NewGroup returns a group, whose jobs run on a [nursery.Bounded] nursery with the given bound and options.
+// Combinators wait for their futures without occupying a slot, as their jobs only start once the futures resolved.
+//
+//nolint:varnamelen // n is perfectly fine
+func NewGroup(ctx context.Context, n int, opts ...nursery.Option) *Group {
+	return &Group{nursery: nursery.NewBounded[struct{}](ctx, n, opts...), mx: sync.Mutex{}, futures: nil}
+}
+
+// Context returns the context of the group's jobs, see [nursery.Bounded.Context].
+func (group *Group) Context() context.Context {
+	return group.nursery.Context()
+}
+
+// Cancel cancels the group's jobs, see [nursery.Bounded.Cancel].
+func (group *Group) Cancel() {
+	group.nursery.Cancel()
+}
+
+// Wait blocks until all jobs of the group, including those of combinators, are finished.
+// Futures, whose jobs never ran, e.g. as the group was cancelled, fail with [nursery.ErrSkipped] once it returns.
+// If a job panicked, the panic is rethrown, see [nursery.Bounded.Wait].
+func (group *Group) Wait() {
+	defer group.skip()
+
+	group.nursery.Wait()
+}
+
+// skip resolves the futures, that did not resolve, once all jobs are finished.
+func (group *Group) skip() {
+	group.mx.Lock()
+	futures := group.futures
+	group.mx.Unlock()
+
+	for _, future := range futures {
+		future.skip()
+	}
+}
+
+// Future is the eventual value of a job owned by a [Group].
+// It is safe for concurrent use.
+type Future[T any] struct {
+	group *Group
+	mx    sync.Mutex
+	done  chan struct{}
+	value T
+	err   error
+	// resolved is set once the value is known, callbacks are called with it, see [Future.subscribe].
+	resolved  bool
+	callbacks []func(Go nursery.Scope[struct{}])
+}
+
+func newFuture[T any](group *Group) *Future[T] {
+	var zero T
+
+	future := &Future[T]{
+		group:     group,
+		mx:        sync.Mutex{},
+		done:      make(chan struct{}),
+		value:     zero,
+		err:       nil,
+		resolved:  false,
+		callbacks: nil,
+	}
+
+	group.mx.Lock()
+	group.futures = append(group.futures, future)
+	group.mx.Unlock()
+
+	return future
+}
+
+// Go runs the job on the group's nursery and returns its future.
+// The job is passed the context of the group, see [Group.Context].
+func Go[T any](group *Group, job func(ctx context.Context) (T, error)) *Future[T] {
+	if job == nil {
+		panic("job must not be nil")
+	}
+
+	future := newFuture[T](group)
+	future.run(group.nursery.GoScoped, job)
+
+	return future
+}
+
+// Then returns a future of f applied to the value of the future, once it resolved,
+// or of the future's error, without calling f, if it failed.
+func Then[T, S any](future *Future[T], f func(ctx context.Context, value T) (S, error)) *Future[S] {
+	if f == nil {
+		panic("f must not be nil")
+	}
+
+	next := newFuture[S](future.group)
+
+	future.subscribe(func(Go nursery.Scope[struct{}]) {
+		if future.err != nil {
+			var zero S
+
+			next.resolve(zero, future.err, Go)
+
+			return
+		}
+
+		next.run(Go, func(ctx context.Context) (S, error) { return f(ctx, future.value) })
+	})
+
+	return next
+}
+
+// Catch returns a future of f applied to the error of the future, once it failed,
+// e.g. to fall back to a default, or of the future's value, without calling f, if it succeeded.
+func Catch[T any](future *Future[T], f func(ctx context.Context, err error) (T, error)) *Future[T] {
+	if f == nil {
+		panic("f must not be nil")
+	}
+
+	next := newFuture[T](future.group)
+
+	future.subscribe(func(Go nursery.Scope[struct{}]) {
+		if future.err == nil {
+			next.resolve(future.value, nil, Go)
+
+			return
+		}
+
+		next.run(Go, func(ctx context.Context) (T, error) { return f(ctx, future.err) })
+	})
+
+	return next
+}
+
+// AllOf returns a future of the values of all futures, in the same order, once all of them resolved,
+// or of the first error, as soon as one of them failed.
+// The futures must belong to the same group.
+func AllOf[T any](futures ...*Future[T]) *Future[[]T] {
+	group := groupOf(futures)
+	next := newFuture[[]T](group)
+
+	var (
+		mx        sync.Mutex
+		values    = make([]T, len(futures))
+		remaining = len(futures)
+	)
+
+	for i, future := range futures {
+		future.subscribe(func(Go nursery.Scope[struct{}]) {
+			if future.err != nil {
+				next.resolve(nil, future.err, Go)
+
+				return
+			}
+
+			mx.Lock()
+			values[i] = future.value
+			remaining--
+			complete := remaining == 0
+			mx.Unlock()
+
+			if complete {
+				next.resolve(values, nil, Go)
+			}
+		})
+	}
+
+	return next
+}
+
+// AnyOf returns a future of the value of the first future, that succeeded,
+// or of the errors of all futures joined, if all of them failed.
+// The futures must belong to the same group.
+func AnyOf[T any](futures ...*Future[T]) *Future[T] {
+	group := groupOf(futures)
+	next := newFuture[T](group)
+
+	var (
+		mx   sync.Mutex
+		errs = make([]error, 0, len(futures))
+	)
+
+	for _, future := range futures {
+		future.subscribe(func(Go nursery.Scope[struct{}]) {
+			if future.err == nil {
+				next.resolve(future.value, nil, Go)
+
+				return
+			}
+
+			mx.Lock()
+			errs = append(errs, future.err)
+			failed := len(errs) == len(futures)
+			mx.Unlock()
+
+			if failed {
+				var zero T
+
+				next.resolve(zero, errors.Join(errs...), Go)
+			}
+		})
+	}
+
+	return next
+}
+
+// Done returns a channel, that is closed once the future resolved.
+func (future *Future[T]) Done() <-chan struct{} {
+	return future.done
+}
+
+// Await blocks until the future resolved and returns its value and error,
+// or the cause of ctx, if it is done before.
+func (future *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-future.done:
+		return future.value, future.err
+	case <-ctx.Done():
+		var zero T
+
+		return zero, context.Cause(ctx)
+	}
+}
+
+// run runs the job via Go and resolves the future with its value,
+// or with [ErrAbandoned], if it does not return.
+func (future *Future[T]) run(Go nursery.Scope[struct{}], job func(ctx context.Context) (T, error)) {
+	ctx := future.group.Context()
+
+	Go(func(Go nursery.Scope[struct{}]) struct{} {
+		returned := false
+
+		defer func() {
+			if !returned {
+				var zero T
+
+				future.resolve(zero, ErrAbandoned, Go)
+			}
+		}()
+
+		value, err := job(ctx)
+		returned = true
+
+		future.resolve(value, err, Go)
+
+		return struct{}{}
+	})
+}
+
+// resolve sets the value of the future, unless it is resolved already, and calls its callbacks,
+// which start their jobs via Go, so they are owned by the same group.
+func (future *Future[T]) resolve(value T, err error, Go nursery.Scope[struct{}]) {
+	future.mx.Lock()
+
+	if future.resolved {
+		future.mx.Unlock()
+
+		return
+	}
+
+	future.value, future.err, future.resolved = value, err, true
+	callbacks := future.callbacks
+	future.callbacks = nil
+	close(future.done)
+
+	future.mx.Unlock()
+
+	for _, callback := range callbacks {
+		callback(Go)
+	}
+}
+
+// skip resolves the future with [nursery.ErrSkipped], unless it is resolved already.
+// Jobs of callbacks are dropped, as the group is finished, so their futures are skipped, too.
+func (future *Future[T]) skip() {
+	var zero T
+
+	future.resolve(zero, nursery.ErrSkipped, func(func(Go nursery.Scope[struct{}]) struct{}) {})
+}
+
+// subscribe calls the callback once the future resolved.
+// If it is resolved already, the callback starts its jobs on the group's nursery.
+func (future *Future[T]) subscribe(callback func(Go nursery.Scope[struct{}])) {
+	future.mx.Lock()
+
+	if !future.resolved {
+		future.callbacks = append(future.callbacks, callback)
+		future.mx.Unlock()
+
+		return
+	}
+
+	future.mx.Unlock()
+
+	callback(future.group.nursery.GoScoped)
+}
+
+// groupOf returns the group, all futures belong to.
+func groupOf[T any](futures []*Future[T]) *Group {
+	if len(futures) == 0 {
+		panic("futures must not be empty")
+	}
+
+	group := futures[0].group
+
+	for _, future := range futures[1:] {
+		if future.group != group {
+			panic("futures must belong to the same group")
+		}
+	}
+
+	return group
+}
@@ -0,0 +1,159 @@
+package future_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+	"github.com/lukasngl/nursery/future"
+)
+
+var errFailed = errors.New("failed")
+
+func value[T any](v T) func(context.Context) (T, error) {
+	return func(context.Context) (T, error) { return v, nil }
+}
+
+func failure[T any](context.Context) (T, error) {
+	var zero T
+
+	return zero, errFailed
+}
+
+func TestThen(t *testing.T) {
+	t.Parallel()
+
+	group := future.NewGroup(context.TODO(), 2)
+
+	formatted := future.Then(future.Go(group, value(42)), func(_ context.Context, v int) (string, error) {
+		return strconv.Itoa(v), nil
+	})
+
+	group.Wait()
+
+	if v, err := formatted.Await(context.TODO()); err != nil || v != "42" {
+		t.Fatalf("expected the continued value, got %q, %v", v, err)
+	}
+}
+
+func TestThen_PropagatesError(t *testing.T) {
+	t.Parallel()
+
+	group := future.NewGroup(context.TODO(), 1)
+
+	called := false
+	next := future.Then(future.Go(group, failure[int]), func(context.Context, int) (int, error) {
+		called = true
+
+		return 0, nil
+	})
+
+	group.Wait()
+
+	if _, err := next.Await(context.TODO()); !errors.Is(err, errFailed) || called {
+		t.Fatalf("expected the error to skip the continuation, got %v, called %t", err, called)
+	}
+}
+
+func TestCatch(t *testing.T) {
+	t.Parallel()
+
+	group := future.NewGroup(context.TODO(), 1)
+
+	recovered := future.Catch(future.Go(group, failure[int]), func(_ context.Context, err error) (int, error) {
+		if !errors.Is(err, errFailed) {
+			t.Errorf("expected the future's error, got %v", err)
+		}
+
+		return -1, nil
+	})
+	passed := future.Catch(future.Go(group, value(1)), func(context.Context, error) (int, error) {
+		return -1, nil
+	})
+
+	group.Wait()
+
+	if v, err := recovered.Await(context.TODO()); err != nil || v != -1 {
+		t.Fatalf("expected the fallback, got %d, %v", v, err)
+	}
+
+	if v, err := passed.Await(context.TODO()); err != nil || v != 1 {
+		t.Fatalf("expected the value to pass, got %d, %v", v, err)
+	}
+}
+
+func TestAllOf(t *testing.T) {
+	t.Parallel()
+
+	group := future.NewGroup(context.TODO(), 3)
+
+	all := future.AllOf(future.Go(group, value(1)), future.Go(group, value(2)), future.Go(group, value(3)))
+
+	values, err := all.Await(context.TODO())
+	if err != nil || !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("expected all values in order, got %v, %v", values, err)
+	}
+
+	failed := future.AllOf(future.Go(group, value(1)), future.Go(group, failure[int]))
+
+	group.Wait()
+
+	if _, err := failed.Await(context.TODO()); !errors.Is(err, errFailed) {
+		t.Fatalf("expected the error of the failed future, got %v", err)
+	}
+}
+
+func TestAnyOf(t *testing.T) {
+	t.Parallel()
+
+	group := future.NewGroup(context.TODO(), 2)
+
+	first := future.AnyOf(future.Go(group, failure[int]), future.Go(group, value(2)))
+	none := future.AnyOf(future.Go(group, failure[int]), future.Go(group, failure[int]))
+
+	group.Wait()
+
+	if v, err := first.Await(context.TODO()); err != nil || v != 2 {
+		t.Fatalf("expected the value of the succeeding future, got %d, %v", v, err)
+	}
+
+	if _, err := none.Await(context.TODO()); !errors.Is(err, errFailed) {
+		t.Fatalf("expected the joined errors, got %v", err)
+	}
+}
+
+func TestGroup_SkipsCancelledFutures(t *testing.T) {
+	t.Parallel()
+
+	group := future.NewGroup(context.TODO(), 1)
+	group.Cancel()
+
+	skipped := future.Then(future.Go(group, value(1)), func(_ context.Context, v int) (int, error) { return v, nil })
+
+	group.Wait()
+
+	if _, err := skipped.Await(context.TODO()); !errors.Is(err, nursery.ErrSkipped) {
+		t.Fatalf("expected the futures of skipped jobs to fail, got %v", err)
+	}
+}
+
+func TestGroup_AbandonedOnPanic(t *testing.T) {
+	t.Parallel()
+
+	group := future.NewGroup(context.TODO(), 1)
+
+	panicking := future.Go(group, func(context.Context) (int, error) { panic("boom") })
+
+	func() {
+		defer func() { _ = recover() }()
+
+		group.Wait()
+	}()
+
+	if _, err := panicking.Await(context.TODO()); !errors.Is(err, future.ErrAbandoned) {
+		t.Fatalf("expected the future of the panicking job to be abandoned, got %v", err)
+	}
+}
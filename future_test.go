@@ -0,0 +1,139 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestGoFuture_ResultBeforeWait(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 2)
+
+	release := make(chan struct{})
+	bounded.Go(func() int {
+		<-release
+
+		return 1
+	})
+
+	future := bounded.GoFuture(func(context.Context) int { return 2 })
+
+	<-future.Done()
+
+	if result, ok := future.Result(); !ok || result != 2 || future.Err() != nil {
+		t.Fatalf("expected the future's result, got %d, %t, %v", result, ok, future.Err())
+	}
+
+	close(release)
+
+	if results := bounded.Wait(); len(results) != 2 {
+		t.Fatalf("expected Wait to collect the future's result too, got %v", results)
+	}
+}
+
+func TestGoFuture_Pending(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1)
+
+	release := make(chan struct{})
+	future := bounded.GoFuture(func(context.Context) int {
+		<-release
+
+		return 1
+	})
+
+	if _, ok := future.Result(); ok {
+		t.Fatal("expected no result, while the job runs")
+	}
+
+	close(release)
+	bounded.Wait()
+
+	if result, ok := future.Result(); !ok || result != 1 {
+		t.Fatalf("expected the result once Wait returned, got %d, %t", result, ok)
+	}
+}
+
+func TestGoFuture_CancelBeforeStart(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1)
+
+	release := make(chan struct{})
+	bounded.Go(func() int {
+		<-release
+
+		return 1
+	})
+
+	ran := false
+	future := bounded.GoFuture(func(context.Context) int {
+		ran = true
+
+		return 2
+	})
+	future.Cancel()
+	close(release)
+
+	<-future.Done()
+
+	if _, ok := future.Result(); ok || ran || !errors.Is(future.Err(), nursery.ErrSkipped) {
+		t.Fatalf("expected the cancelled job to be skipped, got ran %t, %v", ran, future.Err())
+	}
+
+	if results := bounded.Wait(); len(results) != 1 {
+		t.Fatalf("expected only the result of the other job, got %v", results)
+	}
+}
+
+func TestGoFuture_CancelWhileRunning(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[error](context.TODO(), 1)
+
+	started := make(chan struct{})
+	future := bounded.GoFuture(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+
+	<-started
+	future.Cancel()
+
+	<-future.Done()
+
+	if err, ok := future.Result(); !ok || !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the job's context to be cancelled, got %v, %t", err, ok)
+	}
+
+	bounded.Wait()
+}
+
+func TestGoFuture_SkippedOnStop(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1)
+
+	release := make(chan struct{})
+	bounded.Go(func() int {
+		<-release
+
+		return 1
+	})
+
+	future := bounded.GoFuture(func(context.Context) int { return 2 })
+	bounded.Stop()
+	close(release)
+	bounded.Wait()
+
+	if !errors.Is(future.Err(), nursery.ErrSkipped) {
+		t.Fatalf("expected the job to be skipped, got %v", future.Err())
+	}
+}
@@ -2,4 +2,4 @@ module github.com/lukasngl/nursery
 
 go 1.23.3
 
-require golang.org/x/sync v0.13.0
+require go.uber.org/goleak v1.3.0
@@ -0,0 +1,54 @@
+package nursery_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+// exiter is a nursery, whose events can be inspected.
+type exiter interface {
+	Go(job func() int)
+	Wait() []int
+	Events() []nursery.Event
+}
+
+func TestGoexit_FinishesJob(t *testing.T) {
+	t.Parallel()
+
+	for name, subject := range map[string]exiter{
+		"unbounded": nursery.NewUnbounded[int](nursery.WithEvents(16)),
+		"bounded":   nursery.NewBounded[int](context.TODO(), 1, nursery.WithEvents(16)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			subject.Go(func() int {
+				runtime.Goexit()
+
+				return 0
+			})
+			subject.Go(func() int { return 1 })
+
+			if results := subject.Wait(); len(results) != 1 || results[0] != 1 {
+				t.Fatalf("expected only the result of the returning job, got %v", results)
+			}
+
+			if !hasEvent(subject.Events(), 0, nursery.EventExit) {
+				t.Fatalf("expected the exit to be recorded, got %v", subject.Events())
+			}
+		})
+	}
+}
+
+func hasEvent(events []nursery.Event, job int, kind nursery.EventKind) bool {
+	for _, event := range events {
+		if event.Job == job && event.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
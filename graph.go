@@ -0,0 +1,176 @@
+package nursery
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// GraphFormat is the format of a graph rendered by [WithGraph].
+type GraphFormat int
+
+const (
+	// GraphMermaid renders a Mermaid flowchart, which renders in Markdown on many platforms.
+	GraphMermaid GraphFormat = iota
+	// GraphDOT renders a Graphviz digraph.
+	GraphDOT
+)
+
+// node is a job of a graph, with the spans of all its attempts.
+type node struct {
+	job      JobInfo
+	track    string
+	duration time.Duration
+	attempts int
+}
+
+// link records, that the job with the parent index started the child via a [Scope], if the nursery renders a graph.
+func (nursery *Unbounded[R]) link(parent, child int) {
+	if nursery.tracer == nil || parent < 0 {
+		return
+	}
+
+	nursery.tracer.mx.Lock()
+	defer nursery.tracer.mx.Unlock()
+
+	nursery.tracer.parents[child] = parent
+}
+
+// graph writes the recorded jobs as a graph, grouped by the track of their first attempt.
+func (tracer *tracer) graph(writer io.Writer, format GraphFormat) error {
+	tracer.mx.Lock()
+	defer tracer.mx.Unlock()
+
+	var graph strings.Builder
+
+	switch format {
+	case GraphMermaid:
+		tracer.mermaid(&graph)
+	case GraphDOT:
+		tracer.dot(&graph)
+	default:
+		// Unknown formats are rejected by [WithGraph] already.
+		return fmt.Errorf("unknown graph format %d", format)
+	}
+
+	if _, err := io.WriteString(writer, graph.String()); err != nil {
+		return fmt.Errorf("writing graph: %w", err)
+	}
+
+	return nil
+}
+
+// nodes returns the jobs in the order they first started, and the tracks in the order they were first used.
+func (tracer *tracer) nodes() ([]*node, []string) {
+	spans := slices.SortedStableFunc(slices.Values(tracer.spans), func(a, b span) int {
+		return a.start.Compare(b.start)
+	})
+
+	var (
+		nodes  []*node
+		tracks []string
+	)
+
+	byIndex := map[int]*node{}
+
+	for _, span := range spans {
+		job, ok := byIndex[span.job.Index]
+		if !ok {
+			job = &node{job: span.job, track: span.track, duration: 0, attempts: 0}
+			byIndex[span.job.Index] = job
+			nodes = append(nodes, job)
+
+			if span.track != "" && !slices.Contains(tracks, span.track) {
+				tracks = append(tracks, span.track)
+			}
+		}
+
+		job.duration += span.end.Sub(span.start)
+		job.attempts++
+	}
+
+	return nodes, tracks
+}
+
+// label describes the job and how long it ran.
+func (node *node) label() string {
+	label := fmt.Sprintf("%s\n%s", node.job, node.duration.Round(time.Microsecond))
+	if node.attempts > 1 {
+		label += fmt.Sprintf(", %d attempts", node.attempts)
+	}
+
+	return label
+}
+
+func (tracer *tracer) mermaid(graph *strings.Builder) {
+	nodes, tracks := tracer.nodes()
+
+	// Mermaid has no escapes within quoted labels, but entities.
+	escape := strings.NewReplacer(`"`, "#quot;", "\n", "<br/>").Replace
+
+	graph.WriteString("flowchart LR\n")
+
+	for i, track := range append([]string{""}, tracks...) {
+		indent := "\t"
+		if track != "" {
+			fmt.Fprintf(graph, "\tsubgraph track%d [\"%s\"]\n", i, escape(track))
+
+			indent = "\t\t"
+		}
+
+		for _, node := range nodes {
+			if node.track == track {
+				fmt.Fprintf(graph, "%sjob%d[\"%s\"]\n", indent, node.job.Index, escape(node.label()))
+			}
+		}
+
+		if track != "" {
+			graph.WriteString("\tend\n")
+		}
+	}
+
+	for _, node := range nodes {
+		if parent, ok := tracer.parents[node.job.Index]; ok {
+			fmt.Fprintf(graph, "\tjob%d --> job%d\n", parent, node.job.Index)
+		}
+	}
+}
+
+func (tracer *tracer) dot(graph *strings.Builder) {
+	nodes, tracks := tracer.nodes()
+
+	quote := func(label string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(label) + `"`
+	}
+
+	graph.WriteString("digraph nursery {\n\trankdir=LR;\n")
+
+	for i, track := range append([]string{""}, tracks...) {
+		indent := "\t"
+		if track != "" {
+			fmt.Fprintf(graph, "\tsubgraph cluster_%d {\n\t\tlabel=%s;\n", i, quote(track))
+
+			indent = "\t\t"
+		}
+
+		for _, node := range nodes {
+			if node.track == track {
+				fmt.Fprintf(graph, "%sjob%d [label=%s];\n", indent, node.job.Index, quote(node.label()))
+			}
+		}
+
+		if track != "" {
+			graph.WriteString("\t}\n")
+		}
+	}
+
+	for _, node := range nodes {
+		if parent, ok := tracer.parents[node.job.Index]; ok {
+			fmt.Fprintf(graph, "\tjob%d -> job%d;\n", parent, node.job.Index)
+		}
+	}
+
+	graph.WriteString("}\n")
+}
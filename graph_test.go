@@ -0,0 +1,76 @@
+package nursery_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithGraph_Mermaid(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithGraph(&buffer, nursery.GraphMermaid))
+	bounded.GoScoped(func(Go nursery.Scope[int]) int {
+		Go(func(nursery.Scope[int]) int { return 1 })
+		Go(func(nursery.Scope[int]) int { return 2 })
+
+		return 0
+	})
+	bounded.Wait()
+
+	graph := buffer.String()
+
+	for _, want := range []string{
+		"flowchart LR\n",
+		"\tsubgraph track1 [\"worker 0\"]\n",
+		"\t\tjob1[\"job 1<br/>",
+		"\tjob0 --> job1\n",
+		"\tjob0 --> job2\n",
+	} {
+		if !strings.Contains(graph, want) {
+			t.Fatalf("expected %q in the graph, got:\n%s", want, graph)
+		}
+	}
+}
+
+func TestWithGraph_DOT(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	unbounded := nursery.NewUnbounded[int](nursery.WithGraph(&buffer, nursery.GraphDOT))
+	unbounded.GoNamed(`"quoted"`, func() int { return 0 })
+	unbounded.GoScoped(func(Go nursery.Scope[int]) int {
+		Go(func(nursery.Scope[int]) int { return 2 })
+
+		return 1
+	})
+	unbounded.Wait()
+
+	graph := buffer.String()
+
+	for _, want := range []string{
+		"digraph nursery {\n",
+		"\tjob1 -> job2;\n",
+		`job0 [label="job \"\\\"quoted\\\"\"\n`,
+	} {
+		if !strings.Contains(graph, want) {
+			t.Fatalf("expected %q in the graph, got:\n%s", want, graph)
+		}
+	}
+}
+
+func TestWithGraph_UnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	got := recovered(func() { nursery.WithGraph(io.Discard, nursery.GraphFormat(7)) })
+	if got != "unknown graph format 7" {
+		t.Fatalf("expected the option to reject the format, got %q", got)
+	}
+}
@@ -0,0 +1,52 @@
+package nursery
+
+import "sync"
+
+// GroupBy groups the results by their key, keeping the order of the results within each group.
+func GroupBy[R any, K comparable](results []R, key func(result R) K) map[K][]R {
+	groups := map[K][]R{}
+
+	for _, result := range results {
+		k := key(result)
+		groups[k] = append(groups[k], result)
+	}
+
+	return groups
+}
+
+// GroupSink groups results by their key as they arrive, see [Grouped].
+// It is safe for concurrent use.
+type GroupSink[R any, K comparable] struct {
+	mx     sync.Mutex
+	key    func(R) K
+	groups map[K][]R
+}
+
+// Grouped returns a sink, that groups the results by their key during collection,
+// to be passed to [WithSink] via its Write method, instead of grouping them once Wait returns.
+func Grouped[R any, K comparable](key func(result R) K) *GroupSink[R, K] {
+	if key == nil {
+		panic("key must not be nil")
+	}
+
+	return &GroupSink[R, K]{mx: sync.Mutex{}, key: key, groups: map[K][]R{}}
+}
+
+// Write adds the result to its group.
+func (sink *GroupSink[R, K]) Write(result R) {
+	k := sink.key(result)
+
+	sink.mx.Lock()
+	defer sink.mx.Unlock()
+
+	sink.groups[k] = append(sink.groups[k], result)
+}
+
+// Groups returns the results written so far by their key, in the order they were written within each group.
+// It is meant to be called once Wait returned.
+func (sink *GroupSink[R, K]) Groups() map[K][]R {
+	sink.mx.Lock()
+	defer sink.mx.Unlock()
+
+	return sink.groups
+}
@@ -0,0 +1,50 @@
+package nursery_test
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestGroupBy(t *testing.T) {
+	t.Parallel()
+
+	groups := nursery.GroupBy([]int{1, 2, 3, 4, 5}, func(result int) bool { return result%2 == 0 })
+
+	if !slices.Equal(groups[true], []int{2, 4}) || !slices.Equal(groups[false], []int{1, 3, 5}) {
+		t.Fatalf("expected results grouped in order, got %v", groups)
+	}
+}
+
+func TestGrouped_GroupsDuringCollection(t *testing.T) {
+	t.Parallel()
+
+	sink := nursery.Grouped(func(result int) int { return result % 3 })
+
+	results := nursery.WithBounded(context.TODO(), 2, func(Go nursery.Go[int]) {
+		for job := range 9 {
+			Go(func() int { return job })
+		}
+	}, nursery.WithSink(sink.Write))
+
+	if len(results) != 0 {
+		t.Fatalf("expected results to be grouped only, got %v", results)
+	}
+
+	groups := sink.Groups()
+
+	if keys := slices.Sorted(maps.Keys(groups)); !slices.Equal(keys, []int{0, 1, 2}) {
+		t.Fatalf("expected a group per key, got %v", groups)
+	}
+
+	for key, group := range groups {
+		slices.Sort(group)
+
+		if !slices.Equal(group, []int{key, key + 3, key + 6}) {
+			t.Fatalf("expected group %d to hold its results, got %v", key, group)
+		}
+	}
+}
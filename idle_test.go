@@ -0,0 +1,56 @@
+package nursery_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithOnIdle_FiresOnEveryTransition(t *testing.T) {
+	t.Parallel()
+
+	idle := make(chan struct{}, 3)
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithOnIdle(func() {
+		idle <- struct{}{}
+	}))
+
+	release := make(chan struct{})
+
+	for range 3 {
+		bounded.Go(func() int {
+			<-release
+
+			return 0
+		})
+	}
+
+	close(release)
+	<-idle
+
+	bounded.Go(func() int { return 1 })
+	bounded.Wait()
+
+	<-idle
+
+	select {
+	case <-idle:
+		t.Fatal("expected hook to fire once per transition to idle")
+	default:
+	}
+}
+
+func TestWithOnIdle_Inline(t *testing.T) {
+	t.Parallel()
+
+	fired := 0
+
+	nursery.WithBounded(context.TODO(), 1, func(Go nursery.Go[int]) {
+		Go(func() int { return 0 })
+		Go(func() int { return 1 })
+	}, nursery.WithInline(), nursery.WithOnIdle(func() { fired++ }))
+
+	if fired != 1 {
+		t.Fatalf("expected hook to fire once, after all jobs, got %d", fired)
+	}
+}
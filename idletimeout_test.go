@@ -0,0 +1,37 @@
+package nursery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithBounded_IdleTimeoutBetweenBursts(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 2, nursery.WithIdleTimeout(time.Millisecond))
+
+	for burst := range 3 {
+		for job := range 4 {
+			bounded.Go(func() int { return burst*4 + job })
+		}
+
+		// Let the workers go idle, and exit during the later bursts.
+		time.Sleep(time.Duration(burst) * 5 * time.Millisecond)
+	}
+
+	if results := bounded.Wait(); len(results) != 12 {
+		t.Fatalf("expected all 12 jobs to complete, got %v", results)
+	}
+}
+
+func TestWithIdleTimeout_Negative(t *testing.T) {
+	t.Parallel()
+
+	got := recovered(func() { nursery.WithIdleTimeout(-time.Second) })
+	if got != "idle timeout must not be negative, but was -1s" {
+		t.Fatalf("expected a negative timeout to panic, got %v", got)
+	}
+}
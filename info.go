@@ -0,0 +1,45 @@
+package nursery
+
+import (
+	"context"
+	"fmt"
+	"runtime/pprof"
+)
+
+// JobInfo identifies a job of a nursery, e.g. for diagnostics or hooks.
+// Index is the position of the job in submission order, starting with 0.
+// Name is only set for jobs started via GoNamed, it is carried through errors, events and audit logs,
+// and set as the pprof label "job" of the goroutine running the job, so it shows up in goroutine profiles.
+type JobInfo struct {
+	Index int
+	Tag   string
+	Name  string
+}
+
+// String describes the job via its name, or its index and tag, e.g. job 3 (tag "db").
+func (info JobInfo) String() string {
+	if info.Name != "" {
+		return fmt.Sprintf("job %q", info.Name)
+	}
+
+	if info.Tag != "" {
+		return fmt.Sprintf("job %d (tag %q)", info.Index, info.Tag)
+	}
+
+	return fmt.Sprintf("job %d", info.Index)
+}
+
+// labeled runs the job with the pprof label "job" set to its name, if it has one.
+func labeled[R any](info JobInfo, job func() R) R {
+	if info.Name == "" {
+		return job()
+	}
+
+	var result R
+
+	pprof.Do(context.Background(), pprof.Labels("job", info.Name), func(context.Context) {
+		result = job()
+	})
+
+	return result
+}
@@ -0,0 +1,37 @@
+package nursery
+
+import "sync"
+
+// inline queues jobs, that are run by Wait, see [WithInline].
+type inline struct {
+	mx    sync.Mutex
+	queue []func()
+}
+
+func (inline *inline) push(task func()) {
+	inline.mx.Lock()
+	defer inline.mx.Unlock()
+
+	inline.queue = append(inline.queue, task)
+}
+
+// drain runs the queued jobs in order, including the ones queued while draining.
+func (inline *inline) drain() {
+	for {
+		inline.mx.Lock()
+
+		if len(inline.queue) == 0 {
+			inline.mx.Unlock()
+
+			return
+		}
+
+		task := inline.queue[0]
+		inline.queue[0] = nil
+		inline.queue = inline.queue[1:]
+
+		inline.mx.Unlock()
+
+		task()
+	}
+}
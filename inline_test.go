@@ -0,0 +1,83 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithInline_RunsInSubmissionOrder(t *testing.T) {
+	t.Parallel()
+
+	results := nursery.WithBounded(context.TODO(), 4, func(Go nursery.Go[int]) {
+		for position := range 8 {
+			Go(func() int {
+				return position
+			})
+		}
+	}, nursery.WithInline())
+
+	if !slices.Equal(results, []int{0, 1, 2, 3, 4, 5, 6, 7}) {
+		t.Fatalf("expected jobs to run in submission order, got %v", results)
+	}
+}
+
+func TestWithInline_RunsOnWaitingGoroutine(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithInline())
+
+	started := false
+
+	bounded.Go(func() int {
+		started = true
+
+		return 0
+	})
+
+	if started {
+		t.Fatal("expected job not to start before Wait")
+	}
+
+	bounded.Wait()
+
+	if !started {
+		t.Fatal("expected job to run during Wait")
+	}
+}
+
+func TestWithInline_SkipsAfterCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	results := nursery.WithBounded(ctx, 1, func(Go nursery.Go[int]) {
+		Go(func() int {
+			cancel()
+
+			return 1
+		})
+
+		Go(func() int {
+			return 2
+		})
+	}, nursery.WithInline())
+
+	if !slices.Equal(results, []int{1}) {
+		t.Fatalf("expected jobs after cancellation to be skipped, got %v", results)
+	}
+}
+
+func TestWithInline_Scoped(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithInline())
+	bounded.GoScoped(walk(1, 3))
+
+	if visited := bounded.Wait(); len(visited) != 15 {
+		t.Fatalf("expected all 15 nodes to be visited, got %v", visited)
+	}
+}
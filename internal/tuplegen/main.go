@@ -0,0 +1,104 @@
+// Tuplegen generates the tuples with more than two components, see [nursery.Tuple].
+//
+// It is run via go generate in the root of the module, and writes tuple_gen.go.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// smallest and largest are the numbers of components of the tuples, that are generated.
+const (
+	smallest = 3
+	largest  = 8
+)
+
+var (
+	names = []string{"First", "Second", "Third", "Fourth", "Fifth", "Sixth", "Seventh", "Eighth"}
+	types = []string{"A", "B", "C", "D", "E", "F", "G", "H"}
+)
+
+// component is a single component of a tuple.
+type component struct {
+	Name  string
+	Type  string
+	Param string
+}
+
+// tuple is a generated tuple.
+type tuple struct {
+	Size       int
+	Components []component
+	Last       component
+}
+
+// Types returns the type parameters of the tuple, e.g. "A, B, C".
+func (tuple tuple) Types() string {
+	types := make([]string, len(tuple.Components))
+	for i, component := range tuple.Components {
+		types[i] = component.Type
+	}
+
+	return strings.Join(types, ", ")
+}
+
+var source = template.Must(template.New("tuples").Parse(`// Code generated by tuplegen; DO NOT EDIT.
+
+package nursery
+{{ range . }}
+// Tuple{{ .Size }} is like [Tuple], but with {{ .Size }} components.
+type Tuple{{ .Size }}[{{ .Types }} any] struct {
+{{- range .Components }}
+	{{ .Name }} {{ .Type }}
+{{- end }}
+}
+
+// Unpack is like [Tuple.Unpack].
+func (t Tuple{{ .Size }}[{{ .Types }}]) Unpack() ({{ .Types }}) {
+	return {{ range $i, $c := .Components }}{{ if $i }}, {{ end }}t.{{ $c.Name }}{{ end }}
+}
+
+// NewTuple{{ .Size }} is like [NewTuple], but with {{ .Size }} components.
+func NewTuple{{ .Size }}[{{ .Types }} any]({{ range $i, $c := .Components }}{{ if $i }}, {{ end }}{{ $c.Param }} {{ $c.Type }}{{ end }}) Tuple{{ .Size }}[{{ .Types }}] {
+	return Tuple{{ .Size }}[{{ .Types }}]{ {{- range $i, $c := .Components }}{{ if $i }}, {{ end }}{{ $c.Param }}{{ end -}} }
+}
+
+// mapError replaces the last component, if it is a non nil error, see [mapError].
+func (t Tuple{{ .Size }}[{{ .Types }}]) mapError(mapping func(error) error) any {
+	t.{{ .Last.Name }} = mapError(t.{{ .Last.Name }}, mapping)
+
+	return t
+}
+{{ end }}`))
+
+func main() {
+	tuples := make([]tuple, 0, largest-smallest+1)
+
+	for size := smallest; size <= largest; size++ {
+		components := make([]component, size)
+		for i := range components {
+			components[i] = component{Name: names[i], Type: types[i], Param: strings.ToLower(types[i])}
+		}
+
+		tuples = append(tuples, tuple{Size: size, Components: components, Last: components[size-1]})
+	}
+
+	var buffer bytes.Buffer
+	if err := source.Execute(&buffer, tuples); err != nil {
+		log.Fatal(err)
+	}
+
+	formatted, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("tuple_gen.go", formatted, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
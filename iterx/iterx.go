@@ -0,0 +1,103 @@
+// Package iterx adds concurrency to iterator pipelines, with the structured scope of a bounded nursery:
+// every adapter joins its jobs, before the iteration over its sequence returns.
+package iterx
+
+import (
+	"context"
+	"iter"
+
+	"github.com/lukasngl/nursery"
+)
+
+// ParallelMap returns a sequence of f applied to the elements of seq, in the same order,
+// while f is called for at most n elements in parallel on a [nursery.Bounded] nursery.
+// seq is consumed ahead of the results by up to n elements, on a goroutine of its own.
+// Once the iteration stops early, elements that were not mapped yet are dropped,
+// and the iteration returns once all running calls of f returned.
+// If f panics, the panic is rethrown by the iteration, like by [nursery.Bounded.Wait].
+//
+//nolint:varnamelen // n is perfectly fine
+func ParallelMap[A, B any](seq iter.Seq[A], n int, f func(element A) B) iter.Seq[B] {
+	if f == nil {
+		panic("f must not be nil")
+	}
+
+	if n < 1 {
+		panic(nursery.ErrBoundInvalid)
+	}
+
+	return func(yield func(B) bool) {
+		bounded := nursery.NewBounded[struct{}](context.Background(), n)
+
+		// pending holds the channels of the results in the order of the elements, to bound the read-ahead.
+		pending := make(chan chan B, n)
+		stop, produced := make(chan struct{}), make(chan struct{})
+
+		go func() {
+			defer close(produced)
+			defer close(pending)
+
+			for element := range seq {
+				result := make(chan B, 1)
+
+				select {
+				case pending <- result:
+				case <-stop:
+					return
+				}
+
+				bounded.Go(func() struct{} {
+					result <- f(element)
+
+					return struct{}{}
+				})
+			}
+		}()
+
+		defer func() {
+			close(stop)
+			bounded.Stop()
+			<-produced
+			bounded.Wait()
+		}()
+
+		for result := range pending {
+			select {
+			case mapped := <-result:
+				if !yield(mapped) {
+					return
+				}
+			case <-bounded.Stopping():
+				// A call of f panicked, which stopped the nursery, so its result never arrives.
+				return
+			}
+		}
+	}
+}
+
+// ParallelFilter returns a sequence of the elements of seq, for which keep reports true, in the same order,
+// while keep is called for at most n elements in parallel, like with [ParallelMap].
+//
+//nolint:varnamelen // n is perfectly fine
+func ParallelFilter[A any](seq iter.Seq[A], n int, keep func(element A) bool) iter.Seq[A] {
+	if keep == nil {
+		panic("keep must not be nil")
+	}
+
+	type kept struct {
+		element A
+		keep    bool
+	}
+
+	mapped := ParallelMap(seq, n, func(element A) kept {
+		return kept{element: element, keep: keep(element)}
+	})
+
+	return func(yield func(A) bool) {
+		for result := range mapped {
+			if result.keep && !yield(result.element) {
+				return
+			}
+		}
+	}
+}
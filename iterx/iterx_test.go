@@ -0,0 +1,112 @@
+package iterx_test
+
+import (
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery/iterx"
+)
+
+func TestParallelMap_KeepsOrder(t *testing.T) {
+	t.Parallel()
+
+	squares := iterx.ParallelMap(slices.Values([]int{5, 1, 4, 2, 3}), 3, func(element int) int {
+		// Later elements finish first, so the order must be restored.
+		time.Sleep(time.Duration(element) * time.Millisecond)
+
+		return element * element
+	})
+
+	if got := slices.Collect(squares); !slices.Equal(got, []int{25, 1, 16, 4, 9}) {
+		t.Fatalf("expected the squares in the order of the elements, got %v", got)
+	}
+}
+
+func TestParallelMap_Bounded(t *testing.T) {
+	t.Parallel()
+
+	var running, peak atomic.Int64
+
+	mapped := iterx.ParallelMap(slices.Values(make([]int, 20)), 2, func(element int) int {
+		current := running.Add(1)
+		defer running.Add(-1)
+
+		for previous := peak.Load(); current > previous && !peak.CompareAndSwap(previous, current); {
+			previous = peak.Load()
+		}
+
+		time.Sleep(time.Millisecond)
+
+		return element
+	})
+
+	if got := slices.Collect(mapped); len(got) != 20 || peak.Load() > 2 {
+		t.Fatalf("expected all elements with at most 2 calls in parallel, got %d elements and %d", len(got), peak.Load())
+	}
+}
+
+func TestParallelMap_StopsEarly(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int64
+
+	naturals := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	mapped := iterx.ParallelMap(naturals, 2, func(element int) int {
+		calls.Add(1)
+
+		return element
+	})
+
+	for element := range mapped {
+		if element == 3 {
+			break
+		}
+	}
+
+	// The read-ahead is bounded, so the infinite sequence is not consumed.
+	if calls.Load() > 10 {
+		t.Fatalf("expected only a few elements to be mapped ahead, got %d", calls.Load())
+	}
+}
+
+func TestParallelMap_RethrowsPanic(t *testing.T) {
+	t.Parallel()
+
+	mapped := iterx.ParallelMap(slices.Values([]int{1, 2, 3}), 2, func(element int) int {
+		if element == 2 {
+			panic("boom")
+		}
+
+		return element
+	})
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected the panic to be rethrown")
+		}
+	}()
+
+	for range mapped {
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	t.Parallel()
+
+	even := iterx.ParallelFilter(slices.Values([]int{1, 2, 3, 4, 5, 6}), 3, func(element int) bool {
+		return element%2 == 0
+	})
+
+	if got := slices.Collect(even); !slices.Equal(got, []int{2, 4, 6}) {
+		t.Fatalf("expected the even elements in order, got %v", got)
+	}
+}
@@ -0,0 +1,62 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+// clock is a nursery, whose jobs report when they started.
+type clock interface {
+	nursery.Source[time.Time]
+	Go(job func() time.Time)
+}
+
+func TestWithStartJitter_SpreadsStarts(t *testing.T) {
+	t.Parallel()
+
+	for name, newNursery := range map[string]func(...nursery.Option) clock{
+		"unbounded": func(opts ...nursery.Option) clock {
+			return nursery.NewUnbounded[time.Time](opts...)
+		},
+		"bounded": func(opts ...nursery.Option) clock {
+			return nursery.NewBounded[time.Time](context.TODO(), 50, opts...)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start := time.Now()
+			clock := newNursery(nursery.WithStartJitter(20 * time.Millisecond))
+
+			for range 50 {
+				clock.Go(time.Now)
+			}
+
+			starts := clock.Wait()
+			if len(starts) != 50 {
+				t.Fatalf("expected all jobs to run, got %d", len(starts))
+			}
+
+			latest := slices.MaxFunc(starts, time.Time.Compare)
+			if latest.Sub(start) < time.Millisecond || latest.Sub(start) > time.Second {
+				t.Fatalf("expected starts to be spread by the jitter, the latest started after %s", latest.Sub(start))
+			}
+		})
+	}
+}
+
+func TestWithStartJitter_SkipsDelayedJobsOnStop(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithStartJitter(time.Hour))
+	bounded.Go(func() int { return 1 })
+	bounded.Stop()
+
+	if results := bounded.Wait(); len(results) != 0 {
+		t.Fatalf("expected the delayed job to be skipped, got %v", results)
+	}
+}
@@ -0,0 +1,68 @@
+package nursery
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Waiter is implemented by nurseries of any result type, see [WaitAll].
+type Waiter interface {
+	// Join blocks until all jobs are finished, like Wait, but discards the results.
+	// The results remain available via Wait.
+	Join()
+}
+
+var (
+	_ Waiter = (*Unbounded[any])(nil)
+	_ Waiter = (*Bounded[any])(nil)
+)
+
+// Join implements [Waiter].
+func (nursery *Unbounded[R]) Join() {
+	nursery.Wait()
+}
+
+// Join implements [Waiter].
+func (nursery *Bounded[R]) Join() {
+	nursery.Wait()
+}
+
+// WaitAll waits for the given nurseries concurrently and returns once all of them are finished.
+// If the context is done before, WaitAll returns the context's error,
+// while the nurseries continue to finish in the background.
+// If a nursery panics, e.g. as a job panicked, the first panic is rethrown, once all nurseries are finished,
+// unless the context was done before.
+func WaitAll(ctx context.Context, nurseries ...Waiter) error {
+	var (
+		joined   sync.WaitGroup
+		panicked atomic.Pointer[any]
+	)
+
+	for _, nursery := range nurseries {
+		joined.Add(1)
+
+		go func() {
+			defer joined.Done()
+			defer rescue(&panicked)
+
+			nursery.Join()
+		}()
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		joined.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		reraise(&panicked)
+
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
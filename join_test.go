@@ -0,0 +1,67 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWaitAll_WaitsForAll(t *testing.T) {
+	t.Parallel()
+
+	numbers := nursery.NewUnbounded[int]()
+	words := nursery.NewBounded[string](context.TODO(), 1)
+
+	numbers.Go(func() int {
+		time.Sleep(time.Millisecond)
+
+		return 42
+	})
+
+	words.Go(func() string {
+		time.Sleep(2 * time.Millisecond)
+
+		return "done"
+	})
+
+	err := nursery.WaitAll(context.TODO(), numbers, words)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results := numbers.Wait(); !slices.Equal(results, []int{42}) {
+		t.Fatalf("expected results to remain available, got %v", results)
+	}
+
+	if results := words.Wait(); !slices.Equal(results, []string{"done"}) {
+		t.Fatalf("expected results to remain available, got %v", results)
+	}
+}
+
+func TestWaitAll_ContextExpires(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	stuck := nursery.NewUnbounded[int]()
+
+	stuck.Go(func() int {
+		<-release
+
+		return 0
+	})
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond)
+	defer cancel()
+
+	err := nursery.WaitAll(ctx, stuck)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline to exceed, got %v", err)
+	}
+
+	close(release)
+	stuck.Wait()
+}
@@ -0,0 +1,100 @@
+package nursery
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+// Limiter caps the number of jobs running at once across several [Bounded] nurseries,
+// see [WithLimiter].
+// It is safe for concurrent use, and can be shared with code outside of nurseries.
+type Limiter struct {
+	tokens chan struct{}
+	// parent is also acquired, if the limiter was nested in another one, see [ContextWithLimit].
+	parent *Limiter
+}
+
+// NewLimiter returns a limiter, that allows n concurrent holders.
+//
+//nolint:varnamelen // n is perfectly fine
+func NewLimiter(n int) *Limiter {
+	if n < 1 {
+		panic(fmt.Sprintf("limit must be at least 1, but was %d", n))
+	}
+
+	return &Limiter{tokens: make(chan struct{}, n), parent: nil}
+}
+
+// Acquire blocks until the limit allows another holder, or returns the context's error once it is done.
+func (limiter *Limiter) Acquire(ctx context.Context) error {
+	select {
+	case limiter.tokens <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if limiter.parent != nil {
+		if err := limiter.parent.Acquire(ctx); err != nil {
+			<-limiter.tokens
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Release frees the limit held by a previous call to Acquire.
+func (limiter *Limiter) Release() {
+	if limiter.parent != nil {
+		limiter.parent.Release()
+	}
+
+	<-limiter.tokens
+}
+
+type limiterKey struct{}
+
+// ContextWithLimit returns a context carrying a new limiter for n concurrent jobs,
+// that [Bounded] nurseries created with the context or one derived from it acquire for every job,
+// unless they are given a limiter via [WithLimiter].
+// This way library code opening its own nurseries respects the caller's concurrency budget.
+// If ctx already carries a limiter, the new one is nested in it, and jobs acquire both.
+//
+// Jobs hold their share of the limit, until they return.
+// So a job of a limited nursery, that waits for a nested nursery sharing the limiter, may deadlock,
+// once all of the limit is held by waiting jobs.
+//
+//nolint:varnamelen // n is perfectly fine
+func ContextWithLimit(ctx context.Context, n int) context.Context {
+	limiter := NewLimiter(n)
+	limiter.parent = LimiterFromContext(ctx)
+
+	return context.WithValue(ctx, limiterKey{}, limiter)
+}
+
+// LimiterFromContext returns the limiter carried by the context, see [ContextWithLimit], or nil.
+func LimiterFromContext(ctx context.Context) *Limiter {
+	limiter, _ := ctx.Value(limiterKey{}).(*Limiter)
+
+	return limiter
+}
+
+var global atomic.Pointer[Limiter]
+
+// SetGlobalLimit sets the process-wide limit, that nurseries created with [WithGlobalLimit] share.
+// It is meant to be called once at startup, calling it again panics.
+// Do not call it within a [testing/synctest] bubble, as its limiter is shared beyond the bubble.
+//
+//nolint:varnamelen // n is perfectly fine
+func SetGlobalLimit(n int) {
+	if !global.CompareAndSwap(nil, NewLimiter(n)) {
+		panic("global limit is already set")
+	}
+}
+
+// GlobalLimiter returns the limiter set by [SetGlobalLimit], or nil if it is not set.
+func GlobalLimiter() *Limiter {
+	return global.Load()
+}
@@ -0,0 +1,132 @@
+package nursery_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithLimiter_SharedAcrossNurseries(t *testing.T) {
+	t.Parallel()
+
+	limiter := nursery.NewLimiter(2)
+	tracker := &concurrencyTracker{running: atomic.Int32{}, highest: atomic.Int32{}}
+
+	first := nursery.NewBounded[int](context.TODO(), 3, nursery.WithLimiter(limiter))
+	second := nursery.NewBounded[int](context.TODO(), 3, nursery.WithLimiter(limiter))
+
+	for job := range 6 {
+		first.Go(func() int { return tracker.run(job) })
+		second.Go(func() int { return tracker.run(job) })
+	}
+
+	if len(first.Wait())+len(second.Wait()) != 12 {
+		t.Fatal("expected all jobs to complete")
+	}
+
+	if tracker.max() > 2 {
+		t.Fatalf("expected at most 2 jobs to run at once, but %d did", tracker.max())
+	}
+}
+
+func TestWithLimiter_CancelledWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	limiter := nursery.NewLimiter(1)
+	if err := limiter.Acquire(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	defer limiter.Release()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Millisecond)
+	defer cancel()
+
+	results := nursery.WithBounded(ctx, 1, func(Go nursery.Go[int]) {
+		Go(func() int { return 1 })
+	}, nursery.WithLimiter(limiter))
+
+	if len(results) != 0 {
+		t.Fatalf("expected the job to be skipped, got %v", results)
+	}
+}
+
+func TestContextWithLimit_InheritedByNestedNurseries(t *testing.T) {
+	t.Parallel()
+
+	ctx := nursery.ContextWithLimit(context.TODO(), 2)
+	tracker := &concurrencyTracker{running: atomic.Int32{}, highest: atomic.Int32{}}
+
+	nursery.WithUnbounded(func(Go nursery.Go[int]) {
+		for range 2 {
+			Go(func() int {
+				// Library code opening its own nursery, that would not know about the others.
+				return len(nursery.WithBounded(nursery.ContextWithLimit(ctx, 4), 4, func(Go nursery.Go[int]) {
+					for job := range 4 {
+						Go(func() int { return tracker.run(job) })
+					}
+				}))
+			})
+		}
+	})
+
+	if tracker.max() > 2 {
+		t.Fatalf("expected at most 2 jobs to run at once, but %d did", tracker.max())
+	}
+}
+
+//nolint:paralleltest // sets the process-wide limit
+func TestSetGlobalLimit(t *testing.T) {
+	// The limit outlives the test, when it is run repeatedly.
+	if nursery.GlobalLimiter() == nil {
+		nursery.SetGlobalLimit(1)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected setting the global limit twice to panic")
+		}
+	}()
+
+	tracker := &concurrencyTracker{running: atomic.Int32{}, highest: atomic.Int32{}}
+
+	nursery.WithBounded(context.TODO(), 4, func(Go nursery.Go[int]) {
+		for job := range 4 {
+			Go(func() int { return tracker.run(job) })
+		}
+	}, nursery.WithGlobalLimit())
+
+	if tracker.max() != 1 {
+		t.Fatalf("expected at most 1 job to run at once, but %d did", tracker.max())
+	}
+
+	nursery.SetGlobalLimit(2)
+}
+
+// concurrencyTracker tracks the highest number of jobs running at once.
+type concurrencyTracker struct {
+	running, highest atomic.Int32
+}
+
+func (tracker *concurrencyTracker) run(result int) int {
+	current := tracker.running.Add(1)
+	defer tracker.running.Add(-1)
+
+	for {
+		highest := tracker.highest.Load()
+		if current <= highest || tracker.highest.CompareAndSwap(highest, current) {
+			break
+		}
+	}
+
+	time.Sleep(time.Millisecond)
+
+	return result
+}
+
+func (tracker *concurrencyTracker) max() int32 {
+	return tracker.highest.Load()
+}
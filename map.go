@@ -0,0 +1,44 @@
+package nursery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Map calls f for every element of in on a [Bounded] nursery running at most n calls in parallel,
+// and returns the results at the same positions, e.g. to fan out requests for a slice of ids.
+// f is passed the nursery's context, see [Bounded.Context].
+// Elements, for which f fails, are left as zero value and their errors are joined.
+// Once ctx is done, elements that were not mapped yet are left as zero value and its cause is returned.
+//
+//nolint:varnamelen // n is perfectly fine
+func Map[T, R any](ctx context.Context, n int, in []T, f func(ctx context.Context, element T) (R, error)) ([]R, error) {
+	if f == nil {
+		panic("f must not be nil")
+	}
+
+	bounded := NewBounded[error](ctx, n)
+	mapped := make([]R, len(in))
+
+	for i, element := range in {
+		bounded.GoContext(func(ctx context.Context) error {
+			result, err := f(ctx, element)
+			if err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+
+			mapped[i] = result
+
+			return nil
+		})
+	}
+
+	errs := bounded.Wait()
+
+	if len(errs) < len(in) {
+		errs = append(errs, context.Cause(ctx))
+	}
+
+	return mapped, errors.Join(errs...)
+}
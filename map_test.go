@@ -0,0 +1,56 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	mapped, err := nursery.Map(context.TODO(), 2, []int{1, 2, 3}, func(_ context.Context, value int) (string, error) {
+		return strconv.Itoa(value * 2), nil
+	})
+
+	if err != nil || !slices.Equal(mapped, []string{"2", "4", "6"}) {
+		t.Fatalf("expected the mapped elements in order, got %v, %v", mapped, err)
+	}
+}
+
+func TestMap_JoinsErrors(t *testing.T) {
+	t.Parallel()
+
+	errNegative := errors.New("negative")
+
+	mapped, err := nursery.Map(context.TODO(), 2, []int{1, -1, 3}, func(_ context.Context, value int) (int, error) {
+		if value < 0 {
+			return 0, errNegative
+		}
+
+		return value, nil
+	})
+
+	if !errors.Is(err, errNegative) || err.Error() != "index 1: negative" || !slices.Equal(mapped, []int{1, 0, 3}) {
+		t.Fatalf("expected the failed element to be left out, got %v, %v", mapped, err)
+	}
+}
+
+func TestMap_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	_, err := nursery.Map(ctx, 1, []int{1}, func(context.Context, int) (int, error) {
+		return 0, nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the mapping to be cancelled, got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package nursery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MapValues calls f for every entry of m on a [Bounded] nursery running at most n calls in parallel,
+// and returns the results under the same keys.
+// Entries, for which f fails, are left out and their errors are joined.
+// Once ctx is done, entries that were not mapped yet are dropped and its cause is returned.
+//
+//nolint:varnamelen // n is perfectly fine
+func MapValues[K comparable, A, B any](
+	ctx context.Context,
+	n int,
+	m map[K]A,
+	f func(key K, value A) (B, error),
+) (map[K]B, error) {
+	if f == nil {
+		panic("f must not be nil")
+	}
+
+	type entry struct {
+		key   K
+		value B
+		err   error
+	}
+
+	entries := WithBounded(ctx, n, func(Go Go[entry]) {
+		for key, value := range m {
+			Go(func() entry {
+				mapped, err := f(key, value)
+				if err != nil {
+					err = fmt.Errorf("key %v: %w", key, err)
+				}
+
+				return entry{key: key, value: mapped, err: err}
+			})
+		}
+	})
+
+	mapped := make(map[K]B, len(entries))
+
+	var errs []error
+
+	for _, entry := range entries {
+		if entry.err != nil {
+			errs = append(errs, entry.err)
+
+			continue
+		}
+
+		mapped[entry.key] = entry.value
+	}
+
+	if len(entries) < len(m) {
+		errs = append(errs, context.Cause(ctx))
+	}
+
+	return mapped, errors.Join(errs...)
+}
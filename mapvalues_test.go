@@ -0,0 +1,61 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"strconv"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestMapValues(t *testing.T) {
+	t.Parallel()
+
+	mapped, err := nursery.MapValues(context.TODO(), 2, map[string]int{"a": 1, "b": 2, "c": 3},
+		func(key string, value int) (string, error) {
+			return key + strconv.Itoa(value), nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := map[string]string{"a": "a1", "b": "b2", "c": "c3"}; !maps.Equal(mapped, want) {
+		t.Fatalf("expected %v, got %v", want, mapped)
+	}
+}
+
+func TestMapValues_LeavesOutFailedEntries(t *testing.T) {
+	t.Parallel()
+
+	errNegative := errors.New("negative")
+
+	mapped, err := nursery.MapValues(context.TODO(), 2, map[string]int{"a": 1, "b": -1},
+		func(_ string, value int) (int, error) {
+			if value < 0 {
+				return 0, errNegative
+			}
+
+			return value * 2, nil
+		})
+
+	if !errors.Is(err, errNegative) || !maps.Equal(mapped, map[string]int{"a": 2}) {
+		t.Fatalf("expected b to fail, got %v and %v", mapped, err)
+	}
+}
+
+func TestMapValues_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	_, err := nursery.MapValues(ctx, 1, map[string]int{"a": 1}, func(string, int) (int, error) {
+		return 0, nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the mapping to be cancelled, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package nursery
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Source is implemented by nurseries collecting results of type R.
+type Source[R any] interface {
+	Wait() []R
+}
+
+var (
+	_ Source[any] = (*Unbounded[any])(nil)
+	_ Source[any] = (*Bounded[any])(nil)
+)
+
+// Sourced is a result, together with the index of the [Source] it originates from.
+type Sourced[R any] struct {
+	Source int
+	Result R
+}
+
+// Merge waits for the given sources concurrently and returns all their results
+// attributed to their source; results are ordered by source, then by collection.
+// If a source panics, e.g. as a job panicked, the first panic is rethrown, once all sources are finished.
+func Merge[R any](sources ...Source[R]) []Sourced[R] {
+	collected := make([][]R, len(sources))
+
+	var (
+		waiting  sync.WaitGroup
+		panicked atomic.Pointer[any]
+	)
+
+	for i, source := range sources {
+		waiting.Add(1)
+
+		go func() {
+			defer waiting.Done()
+			defer rescue(&panicked)
+
+			collected[i] = source.Wait()
+		}()
+	}
+
+	waiting.Wait()
+	reraise(&panicked)
+
+	total := 0
+	for _, results := range collected {
+		total += len(results)
+	}
+
+	merged := make([]Sourced[R], 0, total)
+
+	for source, results := range collected {
+		for _, result := range results {
+			merged = append(merged, Sourced[R]{Source: source, Result: result})
+		}
+	}
+
+	return merged
+}
+
+// MergeStreams forwards the values of all channels to the returned one, as they arrive,
+// and closes it once all channels are closed, e.g. to fan in the results of several nurseries,
+// that are handed to channels via [WithSink] or an [Unbounded.Stream].
+// The order of values is only kept per channel. The returned channel must be drained.
+func MergeStreams[R any](chs ...<-chan R) <-chan R {
+	merged := make(chan R)
+	forwarding := NewVoid()
+
+	for _, ch := range chs {
+		forwarding.Go(func() {
+			for value := range ch {
+				merged <- value
+			}
+		})
+	}
+
+	go func() {
+		forwarding.Wait()
+		close(merged)
+	}()
+
+	return merged
+}
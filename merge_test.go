@@ -0,0 +1,76 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestMerge_AttributesSources(t *testing.T) {
+	t.Parallel()
+
+	first := nursery.NewUnbounded[string]()
+	second := nursery.NewBounded[string](context.TODO(), 1)
+	empty := nursery.NewUnbounded[string]()
+
+	first.Go(func() string { return "a" })
+	second.Go(func() string { return "b" })
+	second.Go(func() string { return "b" })
+
+	merged := nursery.Merge[string](first, empty, second)
+
+	expected := []nursery.Sourced[string]{
+		{Source: 0, Result: "a"},
+		{Source: 2, Result: "b"},
+		{Source: 2, Result: "b"},
+	}
+
+	if !slices.Equal(merged, expected) {
+		t.Fatalf("expected %v, got %v", expected, merged)
+	}
+}
+
+func TestMergeStreams(t *testing.T) {
+	t.Parallel()
+
+	first, second := make(chan int), make(chan int)
+
+	evens := nursery.NewVoid()
+	for i := range 3 {
+		evens.Go(func() { first <- 2 * i })
+	}
+
+	go func() {
+		evens.Wait()
+		close(first)
+	}()
+
+	go func() {
+		defer close(second)
+
+		for i := range 3 {
+			second <- 2*i + 1
+		}
+	}()
+
+	var merged []int
+	for value := range nursery.MergeStreams(first, second) {
+		merged = append(merged, value)
+	}
+
+	slices.Sort(merged)
+
+	if !slices.Equal(merged, []int{0, 1, 2, 3, 4, 5}) {
+		t.Fatalf("expected the values of both channels, got %v", merged)
+	}
+}
+
+func TestMergeStreams_None(t *testing.T) {
+	t.Parallel()
+
+	if _, open := <-nursery.MergeStreams[int](); open {
+		t.Fatal("expected the merged channel to be closed")
+	}
+}
@@ -0,0 +1,37 @@
+package nursery_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestGoNamed_CarriesName(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[error](context.TODO(), 1, nursery.WithErrorWrapping(), nursery.WithEvents(8))
+	bounded.GoNamed("fetch users", func() error { return io.EOF })
+
+	if err := bounded.Wait()[0]; err.Error() != `job "fetch users": EOF` {
+		t.Fatalf("expected the error to be wrapped with the name, got %v", err)
+	}
+
+	for _, event := range bounded.Events() {
+		if event.Name != "fetch users" {
+			t.Fatalf("expected events to carry the name, got %+v", event)
+		}
+	}
+}
+
+func TestUnbounded_GoNamed(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[nursery.Tuple[int, error]](nursery.WithErrorWrapping())
+	unbounded.GoNamed("parse", func() nursery.Tuple[int, error] { return nursery.NewTuple(0, io.EOF) })
+
+	if err := unbounded.Wait()[0].Second; err.Error() != `job "parse": EOF` {
+		t.Fatalf("expected the error to be wrapped with the name, got %v", err)
+	}
+}
@@ -15,31 +15,141 @@ package nursery
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
-
-	"golang.org/x/sync/semaphore"
+	"sync/atomic"
+	"time"
 )
 
 type Go[R any] = func(job func() R)
 
+// Unbounded is a nursery, that runs all jobs in parallel.
+// It is safe to start jobs from multiple goroutines concurrently.
+// The zero value is a nursery without options, that is ready to use, e.g. when embedded in a struct,
+// like a [sync.WaitGroup]. It must not be copied after first use.
 type Unbounded[R any] struct {
-	mx              sync.Mutex
-	done            bool
-	resultC         chan R
+	// initialized is done, once the nursery is ready to use, see [Unbounded.init].
+	initialized sync.Once
+	// mx guards closed; submissions only share the lock, so they do not block each other.
+	mx       sync.RWMutex
+	closed   bool
+	waited   sync.Once
+	validate func(R) (R, bool)
+	finalize func([]R) []R
+	// sink is only set, if results are handed off instead of being retained, see [WithSink],
+	// route only, if they are routed to named sinks, see [WithRouter],
+	// and merger only, if they are merged while they are collected, see [WithSortedMerge].
+	sink   func(R)
+	route  func(R) bool
+	merger merger[R]
+	onIdle func()
+	// wrapErrors wraps reported errors with the identity of the job, see [WithErrorWrapping].
+	wrapErrors bool
+	// retry is only set, if failed jobs are retried, see [WithRetry].
+	retry *RetryPolicy
+	// jitter is the maximum random delay before jobs start, see [WithStartJitter].
+	jitter time.Duration
+	// stack is how the stacks of panicking jobs are captured, panicked is the first panic, see [Unbounded.Wait].
+	stack    StackCapture
+	panicked atomic.Pointer[PanicError]
+	// active counts the jobs, that are either running or waiting to be run.
+	active atomic.Int64
+	// starts tracks the jobs, that did not start yet, see [Unbounded.Started].
+	starts starts
+	// tally accumulates how the jobs behaved, see [Unbounded.WaitSummary].
+	tally *tally
+	// failures are the errors reported by the retained results, or by all results with collectErrors,
+	// see [WithErrorCollection].
+	failures      *failures
+	collectErrors bool
+	// spawned counts the goroutines started for jobs.
+	spawned atomic.Int64
+	// running, completed and skipped count jobs, see [Stats].
+	running   atomic.Int64
+	completed atomic.Int64
+	skipped   atomic.Int64
+	// collected counts the results, sample is the fraction of them retained, see [WithSample].
+	collected atomic.Int64
+	sample    float64
+	// ids numbers the jobs, events is only set, if events are kept, see [WithEvents].
+	ids    atomic.Uint64
+	events *ring[Event]
+	// auditLog is only set, if a record is written per job, see [WithAuditLog].
+	auditLog *auditLog
+	// tracer is only set, if a trace or graph is written, see [WithTrace] and [WithGraph];
+	// traceWriter is nil for shards of a [Sharded] nursery.
+	tracer          *tracer
+	traceWriter     io.Writer
+	graphWriter     io.Writer
+	graphFormat     GraphFormat
+	resultC         chan indexed[R]
 	results         []R
 	jobs            sync.WaitGroup
 	resultCollector sync.WaitGroup
+	// indices holds the index of the job of every result, if results are ordered, see [WithSubmissionOrder].
+	indices []int
+	ordered bool
+	// void is set for a [Void] nursery, that neither sends nor collects results.
+	void bool
+	// streamMx guards the results and indices while they are collected, as they may be streamed instead,
+	// see [Unbounded.Stream], and collectorDone, which is set once all results are collected.
+	streamMx      sync.Mutex
+	stream        *stream[R]
+	collectorDone bool
+	// completion closes the channel returned by Done, finished is closed once Wait returns.
+	completion *completion
+	finished   chan struct{}
 }
 
+// Bounded is a nursery, that runs a limited number of jobs in parallel.
+// It is safe to start jobs from multiple goroutines concurrently.
+// Jobs run on a pool of at most n worker goroutines, the others wait in a queue.
 type Bounded[R any] struct {
-	inner     *Unbounded[R]
-	sem       *semaphore.Weighted
-	scheduler sync.WaitGroup
-	//nolint:containedctx // required for the semaphore
+	inner *Unbounded[R]
+	slots *slots
+	// bulkheads are separate slots for resource classes, see [WithBulkheads].
+	bulkheads map[string]*slots
+	pool      *pool
+	delays    *delays
+	waited    sync.Once
+	//nolint:containedctx // required for skipping scheduled jobs
 	ctx context.Context
+	// cancel cancels ctx, see [Bounded.Cancel], stopping is closed once jobs are asked to wrap up.
+	cancel   context.CancelCauseFunc
+	stopping chan struct{}
+	stopped  sync.Once
+	token    *Token
+	// unwatch stops skipping scheduled jobs, once the context is done.
+	unwatch func() bool
+	// limiter is only set, if jobs are limited across nurseries, see [WithLimiter].
+	limiter *Limiter
+	// estimate is only set, if jobs are admitted based on their cost, see [WithCostEstimator].
+	estimate func(JobInfo) time.Duration
+	// stopRampUp stops adding slots, rampedUp is done once no more slots are added.
+	stopRampUp chan struct{}
+	rampedUp   sync.WaitGroup
+	// inline is only set, if jobs are run by Wait, see [WithInline].
+	inline     *inline
+	completion *completion
+	// decisions is only set, if scheduling decisions are logged, see [WithSchedulingLog].
+	decisions *ring[Decision]
+	// quorum is the number of successes required of the total number of jobs, or 0 while it is unknown,
+	// failed counts the failures so far, see [WithQuorum].
+	quorum int
+	total  atomic.Int64
+	failed atomic.Int64
+	// admission is only set, if jobs are checked before they are accepted, see [WithAdmission].
+	admission func(JobInfo) error
+	// backlog is only set, if the jobs waiting for a slot are limited, see [WithQueueLimit].
+	backlog *backlog
+	// direct runs jobs on the submitting goroutine, if a slot is free, see [WithSubmitterRuns].
+	direct bool
 }
 
+//go:generate go run ./internal/tuplegen
+
 // Tuple is an adapter type, to allow using functions with multiple returns types.
+// Tuples with more components are provided via Tuple3 through Tuple8.
 type Tuple[A, B any] struct {
 	First  A
 	Second B
@@ -56,9 +166,20 @@ func NewTuple[A, B any](a A, b B) Tuple[A, B] {
 	return Tuple[A, B]{a, b}
 }
 
+// mapError replaces the second component, if it is a non nil error, see [mapError].
+func (t Tuple[A, B]) mapError(mapping func(error) error) any {
+	t.Second = mapError(t.Second, mapping)
+
+	return t
+}
+
 // WithBounded is the bounded variant of [WithUnbounded].
-func WithBounded[R any](ctx context.Context, n int, run func(Go Go[R])) []R {
-	nursery := NewBounded[R](ctx, n)
+func WithBounded[R any](ctx context.Context, n int, run func(Go Go[R]), opts ...Option) []R {
+	if run == nil {
+		panic("run must not be nil")
+	}
+
+	nursery := NewBounded[R](ctx, n, opts...)
 
 	run(nursery.Go)
 
@@ -67,60 +188,306 @@ func WithBounded[R any](ctx context.Context, n int, run func(Go Go[R])) []R {
 
 // WithUnbounded runs the code block given via the closure with a new nursery
 // and waits for all started tasks to complete.
-func WithUnbounded[R any](run func(Go Go[R])) []R {
-	nursery := NewUnbounded[R]()
+func WithUnbounded[R any](run func(Go Go[R]), opts ...Option) []R {
+	if run == nil {
+		panic("run must not be nil")
+	}
+
+	nursery := NewUnbounded[R](opts...)
 
 	run(nursery.Go)
 
 	return nursery.Wait()
 }
 
+// WithBounded2 is like [WithBounded], but the closure may fail, e.g. during setup.
+// Its error is returned alongside the results, once all started jobs are finished.
+func WithBounded2[R any](ctx context.Context, n int, run func(Go Go[R]) error, opts ...Option) ([]R, error) {
+	if run == nil {
+		panic("run must not be nil")
+	}
+
+	nursery := NewBounded[R](ctx, n, opts...)
+
+	err := run(nursery.Go)
+
+	return nursery.Wait(), err
+}
+
+// WithUnbounded2 is like [WithUnbounded], but the closure may fail, e.g. during setup.
+// Its error is returned alongside the results, once all started jobs are finished.
+func WithUnbounded2[R any](run func(Go Go[R]) error, opts ...Option) ([]R, error) {
+	if run == nil {
+		panic("run must not be nil")
+	}
+
+	nursery := NewUnbounded[R](opts...)
+
+	err := run(nursery.Go)
+
+	return nursery.Wait(), err
+}
+
 // NewUnbounded returns a new nursery, that executes at all jobs in parallel.
-func NewUnbounded[R any]() *Unbounded[R] {
+func NewUnbounded[R any](opts ...Option) *Unbounded[R] {
+	cfg := newConfig(opts)
+	nursery := newUnbounded[R](cfg)
+
+	publish(cfg.expvar, nursery.Stats)
+
+	return nursery
+}
+
+func newUnbounded[R any](cfg config) *Unbounded[R] {
 	nursery := &Unbounded[R]{
-		resultC:         make(chan R),
-		mx:              sync.Mutex{},
-		done:            false,
+		initialized:     sync.Once{},
+		resultC:         make(chan indexed[R]),
+		mx:              sync.RWMutex{},
+		closed:          false,
+		waited:          sync.Once{},
+		validate:        hook[func(R) (R, bool)](cfg.validate, "WithValidator"),
+		finalize:        finalizer[R](cfg),
+		sink:            hook[func(R)](cfg.sink, "WithSink"),
+		route:           hook[func(R) bool](cfg.router, "WithRouter"),
+		merger:          newMerger[R](cfg),
+		onIdle:          cfg.onIdle,
+		wrapErrors:      cfg.wrapErrors,
+		retry:           cfg.retry,
+		jitter:          cfg.jitter,
+		stack:           cfg.stack,
+		panicked:        atomic.Pointer[PanicError]{},
+		active:          atomic.Int64{},
+		starts:          starts{mx: sync.Mutex{}, pending: 0, waiters: nil},
+		tally:           newTally(),
+		failures:        &failures{mx: sync.Mutex{}, errs: nil},
+		collectErrors:   cfg.collectErrors,
+		spawned:         atomic.Int64{},
+		running:         atomic.Int64{},
+		completed:       atomic.Int64{},
+		skipped:         atomic.Int64{},
+		collected:       atomic.Int64{},
+		sample:          cfg.sample,
+		ids:             atomic.Uint64{},
+		events:          nil,
+		auditLog:        nil,
+		tracer:          nil,
+		traceWriter:     cfg.trace,
+		graphWriter:     cfg.graph,
+		graphFormat:     cfg.graphFormat,
 		results:         []R{},
 		jobs:            sync.WaitGroup{},
 		resultCollector: sync.WaitGroup{},
+		indices:         nil,
+		ordered:         cfg.ordered,
+		void:            cfg.void,
+		streamMx:        sync.Mutex{},
+		stream:          nil,
+		collectorDone:   false,
+		completion:      newCompletion(),
+		finished:        make(chan struct{}),
 	}
 
-	nursery.resultCollector.Add(1)
+	if cfg.events > 0 {
+		nursery.events = newRing[Event](cfg.events)
+	}
 
-	go func() {
-		defer nursery.resultCollector.Done()
+	if cfg.audit != nil {
+		nursery.auditLog = newAuditLog(cfg.audit)
+	}
 
-		for err := range nursery.resultC {
-			nursery.results = append(nursery.results, err)
-		}
-	}()
+	if cfg.trace != nil || cfg.graph != nil {
+		nursery.tracer = newTracer()
+	}
+
+	nursery.init()
 
 	return nursery
 }
 
+// init makes the zero value of the nursery ready to use, and starts collecting results, once it is first used.
+func (nursery *Unbounded[R]) init() {
+	nursery.initialized.Do(func() {
+		if nursery.resultC == nil {
+			nursery.resultC = make(chan indexed[R])
+			nursery.sample = 1
+			nursery.results = []R{}
+			nursery.completion = newCompletion()
+			nursery.finished = make(chan struct{})
+			nursery.tally = newTally()
+		}
+
+		if nursery.void {
+			return
+		}
+
+		nursery.resultCollector.Add(1)
+
+		go nursery.collectResults()
+	})
+}
+
+// collectResults merges or routes the results, hands them to the sink or retains them, until all are collected.
+func (nursery *Unbounded[R]) collectResults() {
+	defer nursery.resultCollector.Done()
+
+	for collected := range nursery.resultC {
+		if nursery.merger != nil {
+			nursery.merger.add(collected.result)
+			nursery.retainFailure(collected)
+
+			continue
+		}
+
+		if nursery.route != nil && nursery.route(collected.result) {
+			continue
+		}
+
+		if nursery.sink != nil {
+			nursery.sink(collected.result)
+
+			continue
+		}
+
+		nursery.retain(collected)
+	}
+
+	nursery.endStream()
+}
+
 // NewBounded returns a new nursery, that executes at most n jobs in parallel.
 // Other jobs are scheduled and will wait until they are executed or the context is cancelled.
 //
 //nolint:varnamelen // n is perfectly fine
-func NewBounded[R any](ctx context.Context, n int) *Bounded[R] {
+func NewBounded[R any](ctx context.Context, n int, opts ...Option) *Bounded[R] {
 	if n < 1 {
-		panic(fmt.Sprintf("bound must be at least 1, but was %d", n))
+		panic(fmt.Errorf("%w, but was %d", ErrBoundInvalid, n))
+	}
+
+	cfg := newConfig(opts)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	pool := newPool(cfg.idleTimeout)
+	pool.init, pool.teardown = cfg.workerInit, cfg.workerTeardown
+
+	if cfg.prewarm && !cfg.inline {
+		pool.prewarm(n)
+	}
+
+	nursery := &Bounded[R]{
+		ctx:        ctx,
+		cancel:     cancel,
+		stopping:   make(chan struct{}),
+		token:      &Token{reason: atomic.Pointer[error]{}},
+		stopped:    sync.Once{},
+		inner:      newUnbounded[R](cfg),
+		slots:      newSlots(n, cfg.weights, pool.start),
+		bulkheads:  make(map[string]*slots, len(cfg.bulkheads)),
+		pool:       pool,
+		delays:     newDelays(),
+		waited:     sync.Once{},
+		unwatch:    nil,
+		limiter:    nil,
+		estimate:   cfg.estimate,
+		stopRampUp: make(chan struct{}),
+		rampedUp:   sync.WaitGroup{},
+		inline:     nil,
+		completion: newCompletion(),
+		quorum:     cfg.quorum,
+		total:      atomic.Int64{},
+		failed:     atomic.Int64{},
+		decisions:  nil,
+		admission:  cfg.admission,
+		backlog:    nil,
+		direct:     cfg.submitterRuns,
+	}
+
+	if cfg.decisions > 0 {
+		nursery.decisions = newRing[Decision](cfg.decisions)
+		nursery.slots.decisions = nursery.decisions
 	}
 
-	return &Bounded[R]{
-		ctx:       ctx,
-		inner:     NewUnbounded[R](),
-		sem:       semaphore.NewWeighted(int64(n)),
-		scheduler: sync.WaitGroup{},
+	for name, limit := range cfg.bulkheads {
+		bulkhead := newSlots(limit, nil, pool.start)
+		bulkhead.decisions, bulkhead.bulkhead = nursery.decisions, name
+		nursery.bulkheads[name] = bulkhead
 	}
+
+	nursery.total.Store(int64(cfg.quorumTotal))
+
+	nursery.unwatch = context.AfterFunc(ctx, func() {
+		nursery.token.cancel(context.Cause(ctx))
+		nursery.Stop()
+	})
+
+	if cfg.limiter != nil {
+		nursery.limiter = cfg.limiter()
+	} else {
+		nursery.limiter = LimiterFromContext(ctx)
+	}
+
+	if cfg.inline {
+		nursery.inline = &inline{mx: sync.Mutex{}, queue: nil}
+	} else if cfg.queueLimit >= 0 {
+		nursery.backlog = newBacklog(n+cfg.queueLimit, cfg.queuePolicy)
+	}
+
+	if cfg.rampUp > 0 && n > 1 {
+		nursery.rampUp(n, cfg.rampUp)
+	}
+
+	publish(cfg.expvar, nursery.Stats)
+
+	return nursery
+}
+
+// NewBoundedSimple is like [NewBounded] with [context.Background], for callers that only need to bound the parallelism.
+//
+//nolint:varnamelen // n is perfectly fine
+func NewBoundedSimple[R any](n int, opts ...Option) *Bounded[R] {
+	return NewBounded[R](context.Background(), n, opts...)
+}
+
+// rampUp starts with a single slot and adds another one per interval, until the bound is reached.
+//
+//nolint:varnamelen // n is perfectly fine
+func (nursery *Bounded[R]) rampUp(n int, interval time.Duration) {
+	nursery.slots.resize(1)
+	nursery.rampedUp.Add(1)
+
+	go func() {
+		defer nursery.rampedUp.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for limit := 2; limit <= n; limit++ {
+			select {
+			case <-nursery.stopRampUp:
+				return
+			case <-ticker.C:
+				nursery.slots.resize(limit)
+			}
+		}
+	}()
 }
 
 // Go runs the code given via the closure in the background and collects its result.
 func (nursery *Unbounded[R]) Go(job func() R) {
-	nursery.startSoon(func() {
-		nursery.resultC <- job()
-	})
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.startSoon(JobInfo{Index: 0, Tag: "", Name: ""}, job)
+}
+
+// GoNamed is like [Unbounded.Go], but names the job for diagnostics, see [JobInfo].
+func (nursery *Unbounded[R]) GoNamed(name string, job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.startSoon(JobInfo{Index: 0, Tag: "", Name: name}, job)
 }
 
 // Go runs the code given via the closure in the background and collects its result.
@@ -128,68 +495,613 @@ func (nursery *Unbounded[R]) Go(job func() R) {
 // once other jobs finish.
 // If the [Bounded] nursery's context is finished, the scheduled jobs will not be run.
 func (nursery *Bounded[R]) Go(job func() R) {
-	nursery.scheduler.Add(1)
-	nursery.inner.startSoon(func() {
-		defer nursery.scheduler.Done()
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.GoTagged("", job)
+}
+
+// GoNamed is like [Bounded.Go], but names the job for diagnostics, see [JobInfo].
+func (nursery *Bounded[R]) GoNamed(name string, job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.submit(nursery.slots, JobInfo{Index: 0, Tag: "", Name: name}, func(*Attempt) R { return job() })
+}
+
+// GoTagged is like [Bounded.Go], but assigns the job to the given tag class.
+// Tags only affect scheduling, if the nursery was created with [WithFairQueuing].
+func (nursery *Bounded[R]) GoTagged(tag string, job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.submit(nursery.slots, JobInfo{Index: 0, Tag: tag, Name: ""}, func(*Attempt) R { return job() })
+}
+
+// submit schedules the job in the queue, and runs it once a slot for the tag is available,
+// until it is not requeued anymore.
+//
+// The index of the job is assigned, once it is spawned.
+func (nursery *Bounded[R]) submit(queue *slots, info JobInfo, job func(attempt *Attempt) R) {
+	if err := nursery.trySubmit(queue, info, job, false); err != nil {
+		nursery.reject(err)
+	}
+}
+
+// trySubmit is like submit, but returns why the job was not accepted, instead of panicking.
+// If try is set, it does not block for a full queue, see [Bounded.reserve].
+func (nursery *Bounded[R]) trySubmit(queue *slots, info JobInfo, job func(attempt *Attempt) R, try bool) error {
+	if err := nursery.reserve(try); err != nil {
+		return err
+	}
+
+	task, err := nursery.admit(queue, info, job)
+	if err != nil {
+		nursery.unreserve()
+
+		return err
+	}
+
+	// The task keeps the nursery from completing, so it may run after the lock was released.
+	if nursery.direct && nursery.inline == nil && nursery.inner.jitter == 0 &&
+		task.queue.claim(task.info, func(lane int) { nursery.execute(task, lane) }) {
+		return nil
+	}
+
+	nursery.start(task, 0)
+
+	return nil
+}
+
+// admit creates the task of the job, once it was accepted, see [Bounded.accept].
+func (nursery *Bounded[R]) admit(queue *slots, info JobInfo, job func(attempt *Attempt) R) (*task[R], error) {
+	nursery.inner.mx.RLock()
+	defer nursery.inner.mx.RUnlock()
+
+	if err := nursery.accept(info); err != nil {
+		return nil, err
+	}
+
+	return nursery.newTask(queue, accessShared, info, job, nil), nil
+}
+
+// accept returns why the job cannot be accepted, i.e. [ErrClosed], [ErrAborted] or the rejection of the admission.
+// The nursery's lock must be held.
+func (nursery *Bounded[R]) accept(info JobInfo) error {
+	if nursery.inner.closed {
+		return ErrClosed
+	}
+
+	if nursery.isStopping() {
+		return ErrAborted
+	}
+
+	if nursery.admission != nil {
+		return nursery.admission(info)
+	}
+
+	return nil
+}
+
+// submitAccess is like submit, but schedules the job in the nursery's slots with the given access,
+// once the delay passed.
+func (nursery *Bounded[R]) submitAccess(access access, delay time.Duration, job func() R) {
+	if err := nursery.reserve(false); err != nil {
+		nursery.reject(err)
+
+		return
+	}
+
+	nursery.inner.mx.RLock()
+	defer nursery.inner.mx.RUnlock()
+
+	info := JobInfo{Index: 0, Tag: "", Name: ""}
+	if err := nursery.accept(info); err != nil {
+		nursery.unreserve()
+		nursery.reject(err)
+
+		return
+	}
+
+	nursery.schedule(nursery.slots, access, delay, info, func(*Attempt) R { return job() }, nil)
+}
+
+// spawn is like submit, but does not check whether the nursery is closed,
+// see [Unbounded.spawn].
+func (nursery *Bounded[R]) spawn(queue *slots, info JobInfo, job func(attempt *Attempt) R) {
+	if nursery.backlog != nil {
+		nursery.backlog.add()
+	}
+
+	nursery.schedule(queue, accessShared, 0, info, job, nil)
+}
+
+// schedule creates the task of the job and schedules it once the delay passed, see [Bounded.spawn].
+// The future is resolved once the task is finished, if it is not nil.
+func (nursery *Bounded[R]) schedule(
+	queue *slots,
+	access access,
+	delay time.Duration,
+	info JobInfo,
+	job func(attempt *Attempt) R,
+	future *Future[R],
+) {
+	nursery.start(nursery.newTask(queue, access, info, job, future), delay)
+}
+
+// newTask creates the task of the job, which keeps the nursery from completing, until it is finished.
+func (nursery *Bounded[R]) newTask(
+	queue *slots,
+	access access,
+	info JobInfo,
+	job func(attempt *Attempt) R,
+	future *Future[R],
+) *task[R] {
+	nursery.inner.jobs.Add(1)
+	nursery.inner.enter()
+
+	if nursery.inner.retry != nil {
+		job = retryRequeue(nursery.inner.retry, job)
+	}
+
+	var zero R
+
+	task := &task[R]{
+		info:    nursery.inner.identify(info),
+		queue:   queue,
+		access:  access,
+		attempt: firstAttempt(),
+		job:     job,
+		created: time.Now(),
+		started: time.Time{},
+		result:  zero,
+		future:  future,
+	}
+	task.attempt.job = task.info.Index
+	nursery.inner.record(EventSubmit, task.info)
+
+	return task
+}
+
+// start schedules the task, once the delay passed.
+func (nursery *Bounded[R]) start(task *task[R], delay time.Duration) {
+	if nursery.inline != nil {
+		nursery.inline.push(func() {
+			if !sleep(nursery.ctx, delay) {
+				nursery.finish(task, EventSkip)
+
+				return
+			}
+
+			nursery.runInline(task)
+		})
+
+		return
+	}
+
+	if nursery.inner.jitter > 0 {
+		delay += jitter(nursery.inner.jitter)
+	}
+
+	if delay > 0 {
+		nursery.delays.after(delay, func() {
+			nursery.enqueue(task)
+		}, func() {
+			nursery.finish(task, EventSkip)
+		})
+
+		return
+	}
+
+	nursery.enqueue(task)
+}
+
+// task is a scheduled job, together with its current attempt.
+type task[R any] struct {
+	info JobInfo
+	// queue are the slots, the task is scheduled in.
+	queue *slots
+	// access is the access to the slots, the task requires, e.g. see [Bounded.RunExclusive].
+	access  access
+	attempt *Attempt
+	job     func(attempt *Attempt) R
+	// created is the time the task was submitted, started the time the first attempt started,
+	// result the result of the last one.
+	created time.Time
+	started time.Time
+	result  R
+	// future is only set for jobs started with [Bounded.GoFuture].
+	future *Future[R]
+}
+
+func (nursery *Bounded[R]) enqueue(task *task[R]) {
+	task.queue.enqueue(task.info, task.access, func(lane int) {
+		nursery.execute(task, lane)
+	}, func() {
+		nursery.finish(task, EventSkip)
+	})
+}
+
+// execute runs an attempt of the task, once it got a slot in the given lane.
+func (nursery *Bounded[R]) execute(task *task[R], lane int) {
+	// The slots could not be closed in time, when the nursery was stopped.
+	if nursery.isStopping() || !nursery.admits(task) {
+		nursery.finish(task, EventSkip)
+
+		return
+	}
+
+	task.attempt.lane = lane
+
+	track := ""
+	if nursery.inner.tracer != nil {
+		track = nursery.track(task.queue, lane)
+	}
+
+	result, kind := nursery.run(task, track)
+	if kind != EventFinish {
+		nursery.finish(task, kind)
+
+		return
+	}
+
+	if task.attempt.requeued {
+		delay := task.attempt.delay
+		task.attempt = task.attempt.next()
+
+		nursery.delays.after(delay, func() {
+			nursery.enqueue(task)
+		}, func() {
+			nursery.finish(task, EventSkip)
+		})
+
+		return
+	}
+
+	nursery.tally(result)
+	nursery.inner.collect(task.info, result)
+	nursery.finish(task, EventFinish)
+}
+
+// admits reports whether the estimated duration of the task fits until the deadline, see [WithCostEstimator].
+func (nursery *Bounded[R]) admits(task *task[R]) bool {
+	if nursery.estimate == nil {
+		return true
+	}
+
+	deadline, ok := nursery.ctx.Deadline()
+
+	return !ok || nursery.estimate(task.info) <= time.Until(deadline)
+}
+
+// track names the worker, that runs jobs in the lane of the queue, for traces.
+func (nursery *Bounded[R]) track(queue *slots, lane int) string {
+	for name, bulkhead := range nursery.bulkheads {
+		if bulkhead == queue {
+			return fmt.Sprintf("%s worker %d", name, lane)
+		}
+	}
+
+	return fmt.Sprintf("worker %d", lane)
+}
+
+// run runs the current attempt of the task on the worker named by track, once the limiter allows it.
+// It reports how the attempt finished: [EventSkip], if its future was cancelled, see [Future.Cancel],
+// or the context was done while waiting for the limiter,
+// [EventPanic], if the job panicked, which cancels the nursery, or [EventFinish] otherwise.
+func (nursery *Bounded[R]) run(task *task[R], track string) (R, EventKind) {
+	if task.future.cancelled() {
+		var zero R
+
+		return zero, EventSkip
+	}
+
+	if nursery.limiter != nil {
+		if nursery.limiter.Acquire(nursery.ctx) != nil {
+			var zero R
+
+			return zero, EventSkip
+		}
+
+		defer nursery.limiter.Release()
+	}
+
+	if task.started.IsZero() {
+		task.started = time.Now()
+		nursery.inner.starts.begin()
+		nursery.inner.tally.wait(task.created, task.started)
+	}
+
+	start := time.Now()
+
+	nursery.inner.record(EventStart, task.info)
+	nursery.inner.markRunning()
+	defer nursery.inner.running.Add(-1)
+
+	returned := false
+
+	defer func() {
+		// The job called runtime.Goexit, so the task must be finished while the goroutine unwinds.
+		if !returned {
+			nursery.finish(task, EventExit)
+		}
+	}()
+
+	result, panicked := nursery.inner.guard(task.info, func() R { return task.job(task.attempt) })
+	returned = true
+
+	nursery.inner.trace(task.info, track, start)
+
+	if panicked != nil {
+		nursery.abort(panicked)
+
+		return result, EventPanic
+	}
+
+	task.result = result
+
+	return task.result, EventFinish
+}
+
+// runInline runs all attempts of the task on the current goroutine, see [WithInline].
+func (nursery *Bounded[R]) runInline(task *task[R]) {
+	for ; !nursery.isStopping(); task.attempt = task.attempt.next() {
+		result, kind := nursery.run(task, "inline")
+		if kind != EventFinish {
+			nursery.finish(task, kind)
 
-		err := nursery.sem.Acquire(nursery.ctx, 1)
-		if err != nil {
 			return
 		}
-		defer nursery.sem.Release(1)
 
-		nursery.inner.resultC <- job()
-	})
+		if !task.attempt.requeued {
+			nursery.tally(result)
+			nursery.inner.collect(task.info, result)
+			nursery.finish(task, EventFinish)
+
+			return
+		}
+
+		if !sleep(nursery.ctx, task.attempt.delay) {
+			break
+		}
+	}
+
+	nursery.finish(task, EventSkip)
 }
 
-func (nursery *Unbounded[R]) startSoon(job func()) {
-	nursery.mx.Lock()
-	defer nursery.mx.Unlock()
+// finish marks a job as done, that was started with [Bounded.spawn], and records the given event.
+func (nursery *Bounded[R]) finish(task *task[R], kind EventKind) {
+	switch kind {
+	case EventFinish:
+		nursery.inner.completed.Add(1)
+	case EventSkip:
+		nursery.inner.skipped.Add(1)
+	default:
+	}
+
+	// The job was skipped, before it started.
+	if task.started.IsZero() {
+		nursery.inner.starts.begin()
+	}
+
+	nursery.inner.record(kind, task.info)
+	nursery.inner.audit(task.info, task.started, kind, task.result)
+	nursery.unreserve()
+	nursery.inner.leave()
+	task.future.resolve(task.result, kind)
+	nursery.inner.jobs.Done()
+}
 
-	if nursery.done {
-		panic("nursery is closed")
+func (nursery *Unbounded[R]) startSoon(info JobInfo, job func() R) {
+	if err := nursery.tryStartSoon(info, job); err != nil {
+		panic(err)
 	}
+}
+
+// tryStartSoon is like startSoon, but returns [ErrClosed], instead of panicking, once the nursery is closed.
+func (nursery *Unbounded[R]) tryStartSoon(info JobInfo, job func() R) error {
+	nursery.init()
+	nursery.mx.RLock()
+	defer nursery.mx.RUnlock()
+
+	if nursery.closed {
+		return ErrClosed
+	}
+
+	nursery.spawn(info, job)
+
+	return nil
+}
+
+// spawn starts the job without checking whether the nursery is closed, and collects its result.
+// It must only be called by running jobs, which keep the nursery from completing.
+func (nursery *Unbounded[R]) spawn(info JobInfo, job func() R) {
+	nursery.spawnAfter(0, info, func(JobInfo) R { return job() })
+}
 
+// spawnAfter is like spawn, but starts the job once the delay passed.
+// The job is passed its own info, e.g. so it can be linked to the jobs it starts, see [WithGraph].
+func (nursery *Unbounded[R]) spawnAfter(delay time.Duration, info JobInfo, identified func(info JobInfo) R) {
 	nursery.jobs.Add(1)
+	nursery.enter()
+	nursery.spawned.Add(1)
+
+	info = nursery.identify(info)
+	nursery.record(EventSubmit, info)
+
+	job := func() R { return identified(info) }
+	if nursery.retry != nil {
+		job = retryLoop(nursery.retry, job)
+	}
+
+	submitted := time.Now()
 
 	go func() {
 		defer nursery.jobs.Done()
+		defer nursery.leave()
+
+		if nursery.jitter > 0 {
+			delay += jitter(nursery.jitter)
+		}
+
+		if delay > 0 {
+			time.Sleep(delay)
+		}
+
+		start := time.Now()
+
+		nursery.starts.begin()
+		nursery.tally.wait(submitted, start)
+		nursery.record(EventStart, info)
+		nursery.markRunning()
+
+		returned := false
+
+		defer func() {
+			// The job called runtime.Goexit, so it is recorded while the goroutine unwinds.
+			if !returned {
+				var zero R
+
+				nursery.running.Add(-1)
+				nursery.record(EventExit, info)
+				nursery.audit(info, start, EventExit, zero)
+			}
+		}()
+
+		result, panicked := nursery.guard(info, job)
+		returned = true
+
+		nursery.running.Add(-1)
+
+		nursery.trace(info, "", start)
+
+		if panicked != nil {
+			nursery.record(EventPanic, info)
+			nursery.audit(info, start, EventPanic, result)
+
+			return
+		}
 
-		job()
+		nursery.collect(info, result)
+		nursery.completed.Add(1)
+		nursery.record(EventFinish, info)
+		nursery.audit(info, start, EventFinish, result)
 	}()
 }
 
+// identify assigns the next index to the job.
+func (nursery *Unbounded[R]) identify(info JobInfo) JobInfo {
+	info.Index = int(nursery.ids.Add(1) - 1)
+
+	return info
+}
+
+// enter marks a job as active.
+func (nursery *Unbounded[R]) enter() {
+	nursery.active.Add(1)
+	nursery.starts.submit()
+}
+
+// leave marks an active job as finished and fires the idle hook, if it was the last one.
+func (nursery *Unbounded[R]) leave() {
+	if nursery.active.Add(-1) == 0 && nursery.onIdle != nil {
+		nursery.onIdle()
+	}
+}
+
 // Wait blocks and returns all the collected results, once all jobs are finished.
+// Subsequent calls return the same results.
+// Once Wait is called, starting new jobs panics.
+// If a job panicked, Wait rethrows the first panic as [PanicError], once all jobs are finished.
 func (nursery *Unbounded[R]) Wait() []R {
-	nursery.mx.Lock()
-	defer nursery.mx.Unlock()
+	results := nursery.wait()
+	nursery.rethrow()
 
-	return nursery.wait()
+	return results
+}
+
+// wait is like Wait, but does not rethrow panics.
+func (nursery *Unbounded[R]) wait() []R {
+	nursery.close()
+	nursery.waited.Do(nursery.drain)
+
+	return nursery.results
 }
 
 // Wait blocks and returns all the collected results, once all jobs are finished.
+// Subsequent calls return the same results.
+// Once Wait is called, starting new jobs panics.
+// If a job panicked, the nursery is cancelled, see [Bounded.Cancel], and Wait rethrows the panic like [Unbounded.Wait].
 func (nursery *Bounded[R]) Wait() []R {
-	nursery.inner.mx.Lock()
-	defer nursery.inner.mx.Unlock()
+	results := nursery.wait()
+	nursery.inner.rethrow()
+
+	return results
+}
+
+// wait is like Wait, but does not rethrow panics.
+func (nursery *Bounded[R]) wait() []R {
+	nursery.inner.close()
+	nursery.waited.Do(func() {
+		if nursery.inline != nil {
+			nursery.inline.drain()
+		}
+
+		// Wait until scheduled jobs are cleared
+		nursery.inner.jobs.Wait()
 
-	// Wait until scheduled jobs are cleared
-	nursery.scheduler.Wait()
+		// No jobs are left, that could use the remaining slots or workers.
+		close(nursery.stopRampUp)
+		nursery.rampedUp.Wait()
+		nursery.unwatch()
+		nursery.cancel(nil)
+		nursery.pool.close()
+	})
 
 	return nursery.inner.wait()
 }
 
-func (nursery *Unbounded[R]) wait() []R {
-	if nursery.done {
-		return nil
-	}
+// close makes the nursery reject new jobs.
+func (nursery *Unbounded[R]) close() {
+	nursery.init()
+	nursery.mx.Lock()
+	defer nursery.mx.Unlock()
 
-	nursery.done = true
+	nursery.closed = true
+}
 
+// drain waits until all jobs are finished and their results are collected.
+func (nursery *Unbounded[R]) drain() {
 	nursery.jobs.Wait()
 
 	close(nursery.resultC) // Note: closing the channel will stop the errCollector
 
 	nursery.resultCollector.Wait()
 
-	return nursery.results
+	if nursery.merger != nil {
+		nursery.results = nursery.merger.merged()
+	} else if nursery.ordered {
+		nursery.reorder()
+	}
+
+	if nursery.finalize != nil {
+		nursery.results = nursery.finalize(nursery.results)
+	}
+
+	if nursery.traceWriter != nil {
+		// Failing to write the trace must not fail the nursery.
+		_ = nursery.tracer.write(nursery.traceWriter)
+	}
+
+	if nursery.graphWriter != nil {
+		// Failing to write the graph must not fail the nursery.
+		_ = nursery.tracer.graph(nursery.graphWriter, nursery.graphFormat)
+	}
+
+	nursery.tally.ended = time.Now()
+
+	close(nursery.finished)
 }
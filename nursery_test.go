@@ -2,21 +2,19 @@ package nursery_test
 
 import (
 	"context"
-	"fmt"
-	"math/rand"
-	"reflect"
 	"slices"
 	"testing"
 	"testing/quick"
 	"time"
 
 	"github.com/lukasngl/nursery"
+	"github.com/lukasngl/nursery/nurserytest"
 )
 
 func TestWithUnbounded_Completes(t *testing.T) {
 	t.Parallel()
 
-	property := func(order executionOrder) bool {
+	nurserytest.Orders(t, func(order nurserytest.Order) bool {
 		t.Logf("running with order %s", order)
 
 		completed := nursery.WithUnbounded(func(Go nursery.Go[int]) {
@@ -42,19 +40,13 @@ func TestWithUnbounded_Completes(t *testing.T) {
 		}
 
 		return ok
-	}
-
-	err := quick.Check(property, nil)
-	if err != nil {
-		//nolint
-		t.Fatalf("property did not hold for input: %s", err.(*quick.CheckError).In[0])
-	}
+	}, nil)
 }
 
 func TestWithBounded_Completes(t *testing.T) {
 	t.Parallel()
 
-	property := func(order executionOrder, bound int) bool {
+	property := func(order nurserytest.Order, bound int) bool {
 		// reasonable size
 		bound %= 2 * order.Size()
 		// non-negative
@@ -140,46 +132,3 @@ func TestWithBounded_CancelStopsScheduled(t *testing.T) {
 		t.Fatalf("property did not hold")
 	}
 }
-
-var _ quick.Generator = executionOrder{}
-
-type executionOrder struct {
-	order   []int
-	waiters []chan struct{}
-}
-
-func (order *executionOrder) Size() int {
-	return len(order.order)
-}
-
-func (order *executionOrder) Wait(position int) {
-	<-order.waiters[position]
-}
-
-func (order *executionOrder) Run() {
-	for _, position := range order.order {
-		order.waiters[position] <- struct{}{}
-		close(order.waiters[position])
-	}
-}
-
-func (order executionOrder) String() string {
-	return fmt.Sprint(order.order)
-}
-
-// Generate implements quick.Generator.
-func (order executionOrder) Generate(rand *rand.Rand, size int) reflect.Value {
-	order.order = make([]int, size)
-	order.waiters = make([]chan struct{}, size)
-
-	for i := range size {
-		order.order[i] = i
-		order.waiters[i] = make(chan struct{}, 1)
-	}
-
-	rand.Shuffle(size, func(i, j int) {
-		order.order[i], order.order[j] = order.order[j], order.order[i]
-	})
-
-	return reflect.ValueOf(order)
-}
@@ -0,0 +1,78 @@
+// Package nurseryhttp fans out HTTP requests with a bounded nursery.
+package nurseryhttp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+// Result is the outcome of a single request.
+// The response body is read completely and closed, its content is given as Body.
+type Result struct {
+	Response *http.Response
+	Body     []byte
+	Err      error
+}
+
+// Do sends the requests with at most n in flight and returns their results in the order of the requests.
+// Every request gets a context derived from ctx, limited by the timeout unless it is 0,
+// which also covers reading the body.
+// Once ctx is done, requests that were not sent yet fail with its cause.
+// If client is nil, [http.DefaultClient] is used.
+//
+//nolint:varnamelen // n is perfectly fine
+func Do(ctx context.Context, client *http.Client, requests []*http.Request, n int, timeout time.Duration) []Result {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	results := make([]Result, len(requests))
+	sent := make([]bool, len(requests))
+
+	nursery.WithBounded(ctx, n, func(Go nursery.Go[struct{}]) {
+		for i, request := range requests {
+			Go(func() struct{} {
+				results[i] = do(ctx, client, request, timeout)
+				sent[i] = true
+
+				return struct{}{}
+			})
+		}
+	})
+
+	for i := range results {
+		if !sent[i] {
+			results[i].Err = context.Cause(ctx)
+		}
+	}
+
+	return results
+}
+
+func do(ctx context.Context, client *http.Client, request *http.Request, timeout time.Duration) Result {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	response, err := client.Do(request.WithContext(ctx))
+	if err != nil {
+		return Result{Response: nil, Body: nil, Err: err}
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return Result{Response: response, Body: body, Err: fmt.Errorf("read body: %w", err)}
+	}
+
+	return Result{Response: response, Body: body, Err: nil}
+}
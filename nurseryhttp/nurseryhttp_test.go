@@ -0,0 +1,86 @@
+package nurseryhttp_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery/nurseryhttp"
+)
+
+func TestDo_AlignsResultsWithRequests(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	defer server.Close()
+
+	paths := []string{"/slow", "/a", "/b", "/c"}
+	requests := make([]*http.Request, len(paths))
+
+	for i, path := range paths {
+		request, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		requests[i] = request
+	}
+
+	results := nurseryhttp.Do(context.TODO(), server.Client(), requests, 2, time.Second)
+
+	for i, result := range results {
+		if result.Err != nil || string(result.Body) != paths[i] {
+			t.Fatalf("expected body %q for request %d, got %q and %v", paths[i], i, result.Body, result.Err)
+		}
+	}
+}
+
+func TestDo_PerRequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-release
+	}))
+
+	defer server.Close()
+	defer close(release)
+
+	request, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := nurseryhttp.Do(context.TODO(), server.Client(), []*http.Request{request}, 1, time.Millisecond)
+
+	if !errors.Is(results[0].Err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to time out, got %v", results[0].Err)
+	}
+}
+
+func TestDo_CancelledContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	request, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, "http://localhost", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := nurseryhttp.Do(ctx, nil, []*http.Request{request}, 1, 0)
+
+	if !errors.Is(results[0].Err, context.Canceled) {
+		t.Fatalf("expected the request to be cancelled, got %v", results[0].Err)
+	}
+}
@@ -0,0 +1,44 @@
+// Package nurserytest provides helpers for testing code, that uses nurseries.
+package nurserytest
+
+import (
+	"testing"
+
+	"go.uber.org/goleak"
+)
+
+// Options returns goleak options, that ignore the internal goroutines of nurseries,
+// which are not waited for yet, like result collectors, ramp-ups or idle workers.
+// Goroutines of jobs are still reported.
+func Options() []goleak.Option {
+	return []goleak.Option{
+		goleak.IgnoreAnyFunction("github.com/lukasngl/nursery.(*Unbounded[...]).collectResults"),
+		goleak.IgnoreAnyFunction("github.com/lukasngl/nursery.(*Bounded[...]).rampUp.func1"),
+		goleak.IgnoreAnyFunction("github.com/lukasngl/nursery.(*pool).next"),
+		goleak.IgnoreAnyFunction("github.com/lukasngl/nursery.(*pool).first"),
+	}
+}
+
+// VerifyNoLeaks fails the test, if goroutines started during the test outlive it,
+// e.g. jobs of a nursery, that was never waited for.
+// Goroutines running before VerifyNoLeaks is called are ignored.
+// It must not be used in parallel tests, as it inspects all goroutines.
+func VerifyNoLeaks(t testing.TB, options ...goleak.Option) {
+	t.Helper()
+
+	options = append(append(Options(), goleak.IgnoreCurrent()), options...)
+
+	t.Cleanup(func() {
+		goleak.VerifyNone(t, options...)
+	})
+}
+
+// VerifyTestMain runs the tests and fails, if any goroutines outlive them,
+// see [goleak.VerifyTestMain].
+//
+//	func TestMain(m *testing.M) {
+//		nurserytest.VerifyTestMain(m)
+//	}
+func VerifyTestMain(m *testing.M, options ...goleak.Option) {
+	goleak.VerifyTestMain(m, append(Options(), options...)...)
+}
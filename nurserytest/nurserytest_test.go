@@ -0,0 +1,58 @@
+package nurserytest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+	"github.com/lukasngl/nursery/nurserytest"
+	"go.uber.org/goleak"
+)
+
+//nolint:paralleltest // goleak inspects all goroutines
+func TestVerifyNoLeaks_WaitedNursery(t *testing.T) {
+	nurserytest.VerifyNoLeaks(t)
+
+	nursery.WithBounded(context.TODO(), 2, func(Go nursery.Go[int]) {
+		for job := range 4 {
+			Go(func() int { return job })
+		}
+	}, nursery.WithRampUp(time.Millisecond), nursery.WithIdleTimeout(time.Hour))
+}
+
+//nolint:paralleltest // goleak inspects all goroutines
+func TestOptions_IgnoresInternalGoroutines(t *testing.T) {
+	ignore := goleak.IgnoreCurrent()
+
+	unbounded := nursery.NewUnbounded[int]()
+	bounded := nursery.NewBounded[int](context.TODO(), 2, nursery.WithPrewarm())
+
+	if err := goleak.Find(append(nurserytest.Options(), ignore)...); err != nil {
+		t.Fatalf("expected internal goroutines to be ignored, got %v", err)
+	}
+
+	unbounded.Wait()
+	bounded.Wait()
+}
+
+//nolint:paralleltest // goleak inspects all goroutines
+func TestOptions_ReportsJobs(t *testing.T) {
+	ignore := goleak.IgnoreCurrent()
+
+	release := make(chan struct{})
+	unbounded := nursery.NewUnbounded[int]()
+
+	unbounded.Go(func() int {
+		<-release
+
+		return 1
+	})
+
+	if err := goleak.Find(append(nurserytest.Options(), ignore)...); err == nil {
+		t.Fatal("expected the running job to be reported")
+	}
+
+	close(release)
+	unbounded.Wait()
+}
@@ -0,0 +1,82 @@
+package nurserytest
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+var _ quick.Generator = Order{}
+
+// Order is a random completion order for a number of jobs, generated via [testing/quick].
+// Every job waits for its position, see [Order.Wait], and [Order.Run] releases the positions in order,
+// so the jobs complete in the generated order, no matter how they are scheduled.
+type Order struct {
+	order   []int
+	waiters []chan struct{}
+}
+
+// Size returns the number of positions, i.e. the number of jobs to start.
+func (order Order) Size() int {
+	return len(order.order)
+}
+
+// Wait blocks, until the position is released by Run.
+func (order Order) Wait(position int) {
+	<-order.waiters[position]
+}
+
+// Run releases all positions in order.
+// It does not block, so it can be called before the jobs waiting for the positions started.
+func (order Order) Run() {
+	for _, position := range order.order {
+		order.waiters[position] <- struct{}{}
+		close(order.waiters[position])
+	}
+}
+
+func (order Order) String() string {
+	return fmt.Sprint(order.order)
+}
+
+// Generate implements quick.Generator.
+func (order Order) Generate(rand *rand.Rand, size int) reflect.Value {
+	order.order = make([]int, size)
+	order.waiters = make([]chan struct{}, size)
+
+	for i := range size {
+		order.order[i] = i
+		order.waiters[i] = make(chan struct{}, 1)
+	}
+
+	rand.Shuffle(size, func(i, j int) {
+		order.order[i], order.order[j] = order.order[j], order.order[i]
+	})
+
+	return reflect.ValueOf(order)
+}
+
+// Orders checks, that the property holds for random completion orders,
+// and fails the test with the first order it does not hold for.
+// The property starts a job per position, that waits for its position, see [Order.Wait],
+// and calls [Order.Run] to complete them; config is passed to [quick.Check] and may be nil.
+func Orders(t testing.TB, property func(order Order) bool, config *quick.Config) {
+	t.Helper()
+
+	err := quick.Check(property, config)
+	if err == nil {
+		return
+	}
+
+	var check *quick.CheckError
+	if !errors.As(err, &check) {
+		t.Fatalf("checking orders failed: %v", err)
+
+		return
+	}
+
+	t.Fatalf("property did not hold for order %s", check.In[0])
+}
@@ -0,0 +1,81 @@
+package nurserytest_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+	"github.com/lukasngl/nursery/nurserytest"
+)
+
+func TestOrders_ReleasesEveryPosition(t *testing.T) {
+	t.Parallel()
+
+	nurserytest.Orders(t, func(order nurserytest.Order) bool {
+		var (
+			mx        sync.Mutex
+			completed []int
+		)
+
+		// Every position must be released exactly once, so every job completes.
+		results := nursery.WithUnbounded(func(Go nursery.Go[int]) {
+			for position := range order.Size() {
+				Go(func() int {
+					order.Wait(position)
+
+					mx.Lock()
+					defer mx.Unlock()
+
+					completed = append(completed, position)
+
+					return position
+				})
+			}
+
+			order.Run()
+		})
+
+		slices.Sort(completed)
+		slices.Sort(results)
+
+		return len(results) == order.Size() && slices.Equal(completed, results)
+	}, nil)
+}
+
+func TestOrders_ReportsOrder(t *testing.T) {
+	t.Parallel()
+
+	recorder := &recorder{TB: t, failure: ""}
+
+	done := make(chan struct{})
+
+	// Fatalf exits the goroutine, like it would exit the test.
+	go func() {
+		defer close(done)
+
+		nurserytest.Orders(recorder, func(order nurserytest.Order) bool {
+			results := nursery.WithBounded(context.TODO(), 2, func(Go nursery.Go[int]) {
+				for position := range order.Size() {
+					Go(func() int {
+						order.Wait(position)
+
+						return position
+					})
+				}
+
+				order.Run()
+			})
+
+			return len(results) < 3
+		}, nil)
+	}()
+
+	<-done
+
+	if !strings.HasPrefix(recorder.failure, "property did not hold for order [") {
+		t.Fatalf("expected the failing order, got %q", recorder.failure)
+	}
+}
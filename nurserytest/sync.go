@@ -0,0 +1,65 @@
+package nurserytest
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/lukasngl/nursery"
+)
+
+var (
+	_ nursery.Source[any] = (*Sync[any])(nil)
+	_ nursery.Waiter      = (*Sync[any])(nil)
+)
+
+// errClosed is [nursery.ErrClosed], as the receivers of [Sync] shadow the package.
+var errClosed = nursery.ErrClosed
+
+// Sync is a nursery for unit tests, whose Go runs the job right away on the calling goroutine,
+// so code that submits jobs via a [nursery.Go] behaves deterministically and single-threaded.
+// Jobs started by jobs run right away as well, before the job starting them continues.
+// Panics of jobs propagate to the caller of Go. It must not be used concurrently.
+type Sync[R any] struct {
+	results []R
+	closed  bool
+}
+
+// NewSync returns a new synchronous nursery.
+func NewSync[R any]() *Sync[R] {
+	return &Sync[R]{results: []R{}, closed: false}
+}
+
+// Go runs the job and collects its result.
+// Once Wait is called, starting new jobs panics, like for the other nurseries.
+func (nursery *Sync[R]) Go(job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	if nursery.closed {
+		panic(errClosed)
+	}
+
+	// The job may start further jobs, whose results must come first.
+	result := job()
+	nursery.results = append(nursery.results, result)
+}
+
+// nilJob describes the submission of a nil job including the call site, like the nurseries do.
+func nilJob() string {
+	_, file, line, _ := runtime.Caller(2)
+
+	return fmt.Sprintf("Go called with nil job at %s:%d", file, line)
+}
+
+// Wait returns the collected results, in the order the jobs finished.
+func (nursery *Sync[R]) Wait() []R {
+	nursery.closed = true
+
+	return nursery.results
+}
+
+// Join implements [nursery.Waiter].
+func (nursery *Sync[R]) Join() {
+	nursery.Wait()
+}
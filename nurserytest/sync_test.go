@@ -0,0 +1,62 @@
+package nurserytest_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+	"github.com/lukasngl/nursery/nurserytest"
+)
+
+// fanOut stands in for code under test, that submits jobs to a nursery.
+func fanOut(Go nursery.Go[int], values ...int) {
+	for _, value := range values {
+		Go(func() int {
+			if value > 1 {
+				fanOut(Go, value/2)
+			}
+
+			return value
+		})
+	}
+}
+
+func TestSync_RunsJobsRightAway(t *testing.T) {
+	t.Parallel()
+
+	executor := nurserytest.NewSync[int]()
+	fanOut(executor.Go, 4, 1)
+
+	if results := executor.Wait(); !slices.Equal(results, []int{1, 2, 4, 1}) {
+		t.Fatalf("expected nested jobs to finish before the jobs starting them, got %v", results)
+	}
+}
+
+func TestSync_RejectsJobsAfterWait(t *testing.T) {
+	t.Parallel()
+
+	executor := nurserytest.NewSync[int]()
+	executor.Wait()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Go to panic after Wait")
+		}
+	}()
+
+	executor.Go(func() int { return 1 })
+}
+
+func TestSync_RejectsNilJob(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		message, _ := recover().(string)
+		if !strings.HasPrefix(message, "Go called with nil job at ") || !strings.Contains(message, "sync_test.go:") {
+			t.Fatalf("expected the call site in the panic, got %q", message)
+		}
+	}()
+
+	nurserytest.NewSync[int]().Go(nil)
+}
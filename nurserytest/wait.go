@@ -0,0 +1,75 @@
+package nurserytest
+
+import (
+	"runtime/pprof"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+// jobFrames identify the stacks of goroutines, that run jobs.
+var jobFrames = []string{
+	"github.com/lukasngl/nursery.(*Unbounded[...]).spawnAfter.func1",
+	"github.com/lukasngl/nursery.(*Bounded[...]).run",
+}
+
+// RequireWaitWithin waits for the nursery and returns its results,
+// or fails the test with the stacks of all running jobs, if Wait does not return within the timeout.
+// This keeps a deadlocked job from hanging the test run; the wait itself is left running in the background.
+// If Wait panics, e.g. as a job panicked, the panic is rethrown on the calling goroutine.
+func RequireWaitWithin[R any](t testing.TB, source nursery.Source[R], timeout time.Duration) []R {
+	t.Helper()
+
+	done := make(chan []R, 1)
+	panicked := make(chan any, 1)
+
+	go func() {
+		defer func() {
+			// Wait rethrows panics of jobs, which must fail the test instead of the test binary.
+			if value := recover(); value != nil {
+				panicked <- value
+			}
+		}()
+
+		done <- source.Wait()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case results := <-done:
+		return results
+	case value := <-panicked:
+		panic(value)
+	case <-timer.C:
+		t.Fatalf("nursery did not complete within %s, running jobs:\n\n%s", timeout, runningJobs())
+
+		return nil
+	}
+}
+
+// runningJobs returns the stacks of all goroutines, that run jobs,
+// including the names of named jobs as pprof labels.
+func runningJobs() string {
+	var profile strings.Builder
+
+	// Profiles with debug 1 group goroutines by stack, but include their labels.
+	_ = pprof.Lookup("goroutine").WriteTo(&profile, 1)
+
+	var jobs []string
+
+	for _, stack := range strings.Split(profile.String(), "\n\n") {
+		for _, frame := range jobFrames {
+			if strings.Contains(stack, frame) {
+				jobs = append(jobs, stack)
+
+				break
+			}
+		}
+	}
+
+	return strings.Join(jobs, "\n\n")
+}
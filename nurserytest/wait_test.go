@@ -0,0 +1,72 @@
+package nurserytest_test
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+	"github.com/lukasngl/nursery/nurserytest"
+)
+
+func TestRequireWaitWithin_ReturnsResults(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 2)
+	bounded.Go(func() int { return 1 })
+
+	if results := nurserytest.RequireWaitWithin(t, bounded, time.Second); len(results) != 1 {
+		t.Fatalf("expected the result of the job, got %v", results)
+	}
+}
+
+func TestRequireWaitWithin_DumpsRunningJobs(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	unbounded := nursery.NewUnbounded[int]()
+	unbounded.GoNamed("deadlock", func() int { return deadlocked(release) })
+
+	recorder := &recorder{TB: t, failure: ""}
+
+	done := make(chan struct{})
+
+	// Fatalf exits the goroutine, like it would exit the test.
+	go func() {
+		defer close(done)
+
+		nurserytest.RequireWaitWithin(recorder, unbounded, time.Millisecond)
+	}()
+
+	<-done
+
+	if !strings.Contains(recorder.failure, "nurserytest_test.deadlocked") || !strings.Contains(recorder.failure, `"deadlock"`) {
+		t.Fatalf("expected the stack and name of the running job, got %q", recorder.failure)
+	}
+}
+
+func deadlocked(release chan struct{}) int {
+	<-release
+
+	return 0
+}
+
+// recorder records the failure of a test, without failing it.
+type recorder struct {
+	testing.TB
+
+	failure string
+}
+
+func (recorder *recorder) Helper() {}
+
+func (recorder *recorder) Fatalf(format string, args ...any) {
+	recorder.failure = fmt.Sprintf(format, args...)
+
+	runtime.Goexit()
+}
@@ -0,0 +1,643 @@
+package nursery
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"time"
+)
+
+// Option configures a nursery at construction time.
+type Option func(*config)
+
+type config struct {
+	rampUp  time.Duration
+	weights map[string]int
+	inline  bool
+	onIdle  func()
+	// idleTimeout is how long idle workers wait for new jobs.
+	idleTimeout time.Duration
+	prewarm     bool
+	events      int
+	expvar      string
+	limiter     func() *Limiter
+	wrapErrors  bool
+	retry       *RetryPolicy
+	jitter      time.Duration
+	quorum      int
+	quorumTotal int
+	bulkheads   map[string]int
+	estimate    func(JobInfo) time.Duration
+	audit       io.Writer
+	trace       io.Writer
+	sample      float64
+	stack       StackCapture
+	ordered     bool
+	decisions   int
+	graph       io.Writer
+	graphFormat GraphFormat
+	// void is set by [NewVoid], not by an option, so results are dropped before they reach the collector.
+	void bool
+	// admission is consulted before a job is accepted by a [Bounded] nursery, see [WithAdmission].
+	admission func(JobInfo) error
+	// queueLimit is the number of jobs of a [Bounded] nursery, that may wait for a slot, if it is not negative,
+	// queuePolicy what happens to further jobs, see [WithQueueLimit].
+	queueLimit  int
+	queuePolicy QueuePolicy
+	// submitterRuns makes a [Bounded] nursery run jobs on the submitter, if a slot is free, see [WithSubmitterRuns].
+	submitterRuns bool
+	// collectErrors keeps the errors reported by all results for WaitErr, see [WithErrorCollection].
+	collectErrors bool
+	// workerInit and workerTeardown are run by every worker goroutine of a [Bounded] nursery.
+	workerInit     func()
+	workerTeardown func()
+	// Options for hooks depending on the result type store them as any,
+	// they are asserted to the right type once the nursery is constructed.
+	validate any
+	dedup    any
+	finalize any
+	sink     any
+	router   any
+	merger   any
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		rampUp:         0,
+		weights:        nil,
+		inline:         false,
+		queueLimit:     -1,
+		queuePolicy:    QueueBlock,
+		submitterRuns:  false,
+		collectErrors:  false,
+		onIdle:         nil,
+		idleTimeout:    0,
+		prewarm:        false,
+		events:         0,
+		expvar:         "",
+		limiter:        nil,
+		wrapErrors:     false,
+		retry:          nil,
+		jitter:         0,
+		quorum:         0,
+		quorumTotal:    0,
+		bulkheads:      nil,
+		estimate:       nil,
+		audit:          nil,
+		trace:          nil,
+		sample:         1,
+		stack:          StackFull,
+		ordered:        false,
+		decisions:      0,
+		graph:          nil,
+		graphFormat:    GraphMermaid,
+		void:           false,
+		admission:      nil,
+		workerInit:     nil,
+		workerTeardown: nil,
+		validate:       nil,
+		dedup:          nil,
+		finalize:       nil,
+		sink:           nil,
+		router:         nil,
+		merger:         nil,
+	}
+
+	for _, opt := range opts {
+		if opt == nil {
+			panic("option must not be nil")
+		}
+
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+// WithRampUp makes a [Bounded] nursery start with a single slot and add another
+// slot every interval, until the bound is reached.
+// This avoids hitting a downstream with n simultaneous requests right away.
+func WithRampUp(interval time.Duration) Option {
+	if interval <= 0 {
+		panic("ramp up interval must be positive")
+	}
+
+	return func(cfg *config) {
+		cfg.rampUp = interval
+	}
+}
+
+// WithFairQueuing makes a [Bounded] nursery share its slots between tag classes,
+// see [Bounded.GoTagged], using weighted fair queuing.
+// A class with weight 2 is handed twice as many free slots as a class with weight 1,
+// as long as both have jobs waiting; tags without a weight default to 1.
+func WithFairQueuing(weights map[string]int) Option {
+	for tag, weight := range weights {
+		if weight < 1 {
+			panic(fmt.Sprintf("weight of tag %q must be at least 1, but was %d", tag, weight))
+		}
+	}
+
+	weights = maps.Clone(weights)
+	if weights == nil {
+		weights = map[string]int{}
+	}
+
+	return func(cfg *config) {
+		cfg.weights = weights
+	}
+}
+
+// WithBulkheads adds named bulkheads with the given limits to a [Bounded] nursery, e.g. "db": 10, "s3": 50.
+// Jobs started in a bulkhead, see [Bounded.GoIn], only use its slots instead of the nursery's,
+// so jobs waiting for an exhausted resource do not starve the other jobs.
+// In total, up to n jobs plus the limits of all bulkheads run in parallel.
+func WithBulkheads(limits map[string]int) Option {
+	for name, limit := range limits {
+		if limit < 1 {
+			panic(fmt.Sprintf("limit of bulkhead %q must be at least 1, but was %d", name, limit))
+		}
+	}
+
+	limits = maps.Clone(limits)
+
+	return func(cfg *config) {
+		cfg.bulkheads = limits
+	}
+}
+
+// WithCostEstimator makes a [Bounded] nursery estimate the duration of every job, once it got a slot,
+// and skip it, if the estimate exceeds the time left until the deadline of the nursery's context,
+// as it could not finish in time anyway.
+// Without a deadline, all jobs are run.
+func WithCostEstimator(estimate func(job JobInfo) time.Duration) Option {
+	return func(cfg *config) {
+		cfg.estimate = estimate
+	}
+}
+
+// WithAdmission makes a [Bounded] nursery consult the admission before it accepts a job,
+// e.g. to reject jobs of disabled tenants or tags that exceeded their quota.
+// If it returns an error, the job is not run and the error is returned by [Bounded.TryGo],
+// while Go and its variants panic with it, like with [ErrClosed].
+// The job's index is not assigned yet, see [JobInfo].
+func WithAdmission(admission func(job JobInfo) error) Option {
+	return func(cfg *config) {
+		cfg.admission = admission
+	}
+}
+
+// WithInline makes a [Bounded] nursery run its jobs one after another on the goroutine calling Wait,
+// instead of starting a goroutine per job.
+// This is a cheap sequential mode, e.g. for debugging with simple stack traces,
+// but jobs do not start before Wait is called and the bound as well as tags are ignored.
+func WithInline() Option {
+	return func(cfg *config) {
+		cfg.inline = true
+	}
+}
+
+// WithBlockingSubmit makes Go of a [Bounded] nursery and its variants block the submitter,
+// while as many jobs, as the nursery's bound, were accepted, but did not finish yet,
+// instead of queuing jobs without limit, e.g. to apply backpressure to producers reading from a stream.
+// It is a shorthand for [WithQueueLimit] with no queue and [QueueBlock].
+func WithBlockingSubmit() Option {
+	return WithQueueLimit(0, QueueBlock)
+}
+
+// WithQueueLimit limits the number of jobs of a [Bounded] nursery, that wait for a slot,
+// so memory-sensitive services do not accept unlimited work.
+// Once as many jobs, as the nursery's bound plus the limit, were accepted, but did not finish yet,
+// the policy decides what happens to further jobs submitted via Go and its variants, see [QueuePolicy],
+// while [Bounded.TryGo] returns [ErrQueueFull] right away.
+// Jobs count across the nursery's slots and bulkheads, and delayed jobs count, while they wait for their delay.
+// Jobs submitted via a [Scope] are always accepted, as running jobs would deadlock otherwise.
+// It is ignored together with [WithInline], as jobs do not start before Wait is called.
+func WithQueueLimit(limit int, policy QueuePolicy) Option {
+	if limit < 0 {
+		panic(fmt.Sprintf("queue limit must not be negative, but was %d", limit))
+	}
+
+	return func(cfg *config) {
+		cfg.queueLimit, cfg.queuePolicy = limit, policy
+	}
+}
+
+// WithSubmitterRuns makes a [Bounded] nursery run a job on the goroutine submitting it,
+// if a slot is free and no other job is waiting for one, instead of handing it to a worker,
+// e.g. to avoid the hand-off for the common uncontended case in request handlers.
+// Go and its variants then block, until the job returned.
+// Jobs are handed to a worker as usual, when they are delayed, see [WithStartJitter], or submitted via
+// [Bounded.GoAfterDelay], [Bounded.GoFuture], a [Scope], or to run exclusively.
+// It is ignored together with [WithInline].
+func WithSubmitterRuns() Option {
+	return func(cfg *config) {
+		cfg.submitterRuns = true
+	}
+}
+
+// WithErrorCollection keeps the errors reported by the results of all jobs until WaitErr returns them,
+// see [Unbounded.WaitErr], including errors of results, that are not retained, e.g. due to [WithSample] or [WithSink].
+// The errors are kept for the lifetime of the nursery.
+func WithErrorCollection() Option {
+	return func(cfg *config) {
+		cfg.collectErrors = true
+	}
+}
+
+// WithOnIdle calls the hook, whenever the last running or waiting job finishes,
+// e.g. to flush buffers of a long-lived nursery.
+// It is called on the goroutine of the finished job, so it should return quickly.
+func WithOnIdle(hook func()) Option {
+	return func(cfg *config) {
+		cfg.onIdle = hook
+	}
+}
+
+// WithIdleTimeout makes the workers of a [Bounded] nursery wait for new jobs for the given duration,
+// once no more jobs are queued, before they exit; new workers are started on demand.
+// Without it, workers exit as soon as no job is queued.
+// A long-lived nursery can use it to reuse workers between bursts of jobs,
+// without holding parked goroutines, while there is nothing to do.
+func WithIdleTimeout(timeout time.Duration) Option {
+	if timeout < 0 {
+		panic(fmt.Sprintf("idle timeout must not be negative, but was %v", timeout))
+	}
+
+	return func(cfg *config) {
+		cfg.idleTimeout = timeout
+	}
+}
+
+// WithPrewarm makes a [Bounded] nursery start all of its n workers at construction,
+// so the first burst of jobs does not pay for starting goroutines.
+// Pre-warmed workers wait for their first job until Wait returns, afterwards the idle timeout applies,
+// see [WithIdleTimeout].
+func WithPrewarm() Option {
+	return func(cfg *config) {
+		cfg.prewarm = true
+	}
+}
+
+// WithWorkerInit runs the hook once on every worker goroutine of a [Bounded] nursery, before it runs its first job,
+// e.g. to seed a goroutine-local random number generator or to register the thread with a cgo library,
+// after locking it via [runtime.LockOSThread].
+// With [WithInline], jobs do not run on workers, so the hook is not called.
+func WithWorkerInit(hook func()) Option {
+	return func(cfg *config) {
+		cfg.workerInit = hook
+	}
+}
+
+// WithWorkerTeardown runs the hook once on every worker goroutine of a [Bounded] nursery, before it exits,
+// see [WithWorkerInit]; Wait returns only after all workers were torn down.
+func WithWorkerTeardown(hook func()) Option {
+	return func(cfg *config) {
+		cfg.workerTeardown = hook
+	}
+}
+
+// WithEvents makes the nursery keep its most recent lifecycle events in a ring of the given size,
+// so they can be dumped via Events for post-mortem debugging of a misbehaving nursery.
+func WithEvents(size int) Option {
+	if size < 1 {
+		panic(fmt.Sprintf("event ring size must be at least 1, but was %d", size))
+	}
+
+	return func(cfg *config) {
+		cfg.events = size
+	}
+}
+
+// WithSchedulingLog makes a [Bounded] nursery log its most recent scheduling decisions in a ring of the given size:
+// why a job waited, which slot it got, and when the number of slots changed.
+// The log can be retrieved via SchedulingLog, e.g. after Wait, to diagnose fairness or starvation issues.
+func WithSchedulingLog(size int) Option {
+	if size < 1 {
+		panic(fmt.Sprintf("scheduling log size must be at least 1, but was %d", size))
+	}
+
+	return func(cfg *config) {
+		cfg.decisions = size
+	}
+}
+
+// WithExpvar publishes the nursery's [Stats] via expvar under the given name,
+// as part of the "nurseries" map, so they show up in /debug/vars.
+// A nursery created later with the same name replaces the earlier one.
+func WithExpvar(name string) Option {
+	if name == "" {
+		panic("expvar name must not be empty")
+	}
+
+	return func(cfg *config) {
+		cfg.expvar = name
+	}
+}
+
+// WithLimiter makes a [Bounded] nursery acquire the limiter for every job, in addition to its own slots,
+// so the limiter caps the running jobs of all nurseries sharing it.
+// Jobs hold their slot, while waiting for the limiter.
+// It replaces the limiter carried by the nursery's context, see [ContextWithLimit].
+func WithLimiter(limiter *Limiter) Option {
+	return func(cfg *config) {
+		cfg.limiter = func() *Limiter { return limiter }
+	}
+}
+
+// WithGlobalLimit is like [WithLimiter] for the process-wide limiter, see [SetGlobalLimit].
+// If no global limit is set, once the nursery is created, the option has no effect.
+func WithGlobalLimit() Option {
+	return func(cfg *config) {
+		cfg.limiter = GlobalLimiter
+	}
+}
+
+// WithErrorWrapping wraps the errors reported by jobs with the job's identity,
+// i.e. its index in submission order and its tag, e.g. "job 3 (tag \"db\"): connection refused".
+// Results report errors, if they are an error, or a [Tuple] with an error as second component.
+// Jobs of a [Sharded] nursery are numbered per shard.
+func WithErrorWrapping() Option {
+	return func(cfg *config) {
+		cfg.wrapErrors = true
+	}
+}
+
+// WithRetry retries the jobs of the nursery, while they report retryable errors, see [RetryPolicy].
+// Retries of a [Bounded] nursery are requeued, see [Attempt.Requeue],
+// while an [Unbounded] nursery retries on the job's goroutine.
+// Only the result of the last attempt is collected.
+func WithRetry(policy RetryPolicy) Option {
+	checkRetryPolicy(policy)
+
+	return func(cfg *config) {
+		cfg.retry = &policy
+	}
+}
+
+// WithQuorum makes a [Bounded] nursery cancel its remaining jobs like [Bounded.Cancel],
+// once so many of the total number of jobs failed, that they cannot succeed the given number of times anymore,
+// e.g. once 3 of 5 jobs failed, that require 3 successes, so no slots are wasted on a doomed quorum.
+// Results report failures, if they are an error, or a [Tuple] with an error as second component.
+// The reason of the cancellation is [ErrQuorumUnreachable].
+func WithQuorum(successes, total int) Option {
+	if successes < 1 {
+		panic(fmt.Sprintf("quorum must be at least 1, but was %d", successes))
+	}
+
+	if total < successes {
+		panic(fmt.Sprintf("total must be at least the quorum of %d, but was %d", successes, total))
+	}
+
+	return func(cfg *config) {
+		cfg.quorum, cfg.quorumTotal = successes, total
+	}
+}
+
+// WithStartJitter delays the start of every job by a random duration up to the maximum,
+// to avoid a thundering herd, once hundreds of jobs would hit the same backend at the same time.
+// Jobs of a [Bounded] nursery do not occupy a slot, while they are delayed, and are skipped once it is stopped.
+// Retries are not delayed, and jobs of an inline nursery, see [WithInline], neither.
+func WithStartJitter(maximum time.Duration) Option {
+	if maximum <= 0 {
+		panic("start jitter must be positive")
+	}
+
+	return func(cfg *config) {
+		cfg.jitter = maximum
+	}
+}
+
+// WithAuditLog writes an [AuditRecord] per job to the writer as a JSON line, once the job is done,
+// e.g. for audit trails of batch runs.
+// Records are written one at a time, errors writing them are ignored.
+func WithAuditLog(writer io.Writer) Option {
+	return func(cfg *config) {
+		cfg.audit = writer
+	}
+}
+
+// WithTrace writes a timeline of the jobs in the Chrome trace event format to the writer, once Wait returns,
+// e.g. to inspect scheduling gaps and stragglers of a big fan-out in Perfetto or chrome://tracing.
+// Jobs of a [Bounded] nursery are shown per worker, i.e. per slot, with a span per attempt,
+// while jobs of an [Unbounded] nursery are shown on their own goroutine.
+// Errors writing the trace are ignored.
+func WithTrace(writer io.Writer) Option {
+	return func(cfg *config) {
+		cfg.trace = writer
+	}
+}
+
+// WithGraph renders the jobs as a graph in the given format to the writer, once Wait returns,
+// e.g. to include a picture of what a fan-out did in documentation or bug reports.
+// Every job is a node labeled with its duration, jobs started via a [Scope] are linked to the job starting them,
+// and jobs of a [Bounded] nursery are grouped by the worker, that ran their first attempt.
+// It is ignored by [Sharded] nurseries. Errors writing the graph are ignored.
+func WithGraph(writer io.Writer, format GraphFormat) Option {
+	if format < GraphMermaid || format > GraphDOT {
+		panic(fmt.Sprintf("unknown graph format %d", format))
+	}
+
+	return func(cfg *config) {
+		cfg.graph, cfg.graphFormat = writer, format
+	}
+}
+
+// WithValidator validates every result before it is collected.
+// Invalid results are dropped, unless convert is given,
+// which replaces them by a result reporting the error, e.g. a [Tuple] with the error as second component.
+//
+// Validation happens on the job's goroutine, so validate and convert must be safe for concurrent use.
+func WithValidator[R any](validate func(R) error, convert func(result R, err error) R) Option {
+	if validate == nil {
+		panic("validate must not be nil")
+	}
+
+	return func(cfg *config) {
+		cfg.validate = func(result R) (R, bool) {
+			err := validate(result)
+			if err == nil {
+				return result, true
+			}
+
+			if convert == nil {
+				return result, false
+			}
+
+			return convert(result, err), true
+		}
+	}
+}
+
+// WithFinalizer transforms the collected results once, before Wait returns them,
+// e.g. to sort, deduplicate or truncate them.
+func WithFinalizer[R any](finalize func(results []R) []R) Option {
+	if finalize == nil {
+		panic("finalize must not be nil")
+	}
+
+	return func(cfg *config) {
+		cfg.finalize = finalize
+	}
+}
+
+// WithSample retains only a random sample of the results, each with the given probability,
+// e.g. for massive fan-outs, that only need statistics and a few exemplars.
+// All results are still counted, see [Stats].
+func WithSample(fraction float64) Option {
+	if fraction <= 0 || fraction > 1 {
+		panic(fmt.Sprintf("sample fraction must be in (0, 1], but was %v", fraction))
+	}
+
+	return func(cfg *config) {
+		cfg.sample = fraction
+	}
+}
+
+// WithStackCapture configures, how the stacks of panicking jobs are captured, see [StackCapture].
+// By default, full stacks are captured, which is slow and noisy for thousands of failing jobs.
+func WithStackCapture(mode StackCapture) Option {
+	if mode < StackFull || mode > StackNone {
+		panic(fmt.Sprintf("unknown stack capture %d", mode))
+	}
+
+	return func(cfg *config) {
+		cfg.stack = mode
+	}
+}
+
+// WithSubmissionOrder makes Wait return the results in the order the jobs were submitted,
+// instead of the order they finished, e.g. to map an input slice to an output slice positionally.
+// Jobs started by running jobs, see [Scope], are ordered by the time they were started.
+// Results are ordered before the finalizer runs, see [WithFinalizer];
+// a [Sharded] nursery orders the results of each shard, and results handed to a sink are not ordered, see [WithSink].
+func WithSubmissionOrder() Option {
+	return func(cfg *config) {
+		cfg.ordered = true
+	}
+}
+
+// WithDedup collapses results with the same key into the first one, before Wait returns them,
+// e.g. when multiple sources can return the same item.
+// Deduplication happens before the finalizer runs, see [WithFinalizer].
+func WithDedup[R any, K comparable](key func(result R) K) Option {
+	if key == nil {
+		panic("key must not be nil")
+	}
+
+	return func(cfg *config) {
+		cfg.dedup = func(results []R) []R {
+			seen := make(map[K]struct{}, len(results))
+			unique := results[:0]
+
+			for _, result := range results {
+				k := key(result)
+				if _, ok := seen[k]; ok {
+					continue
+				}
+
+				seen[k] = struct{}{}
+				unique = append(unique, result)
+			}
+
+			return unique
+		}
+	}
+}
+
+// finalizer combines the hooks transforming the results before Wait returns them, or returns nil.
+func finalizer[R any](cfg config) func([]R) []R {
+	dedup := hook[func([]R) []R](cfg.dedup, "WithDedup")
+	finalize := hook[func([]R) []R](cfg.finalize, "WithFinalizer")
+
+	switch {
+	case dedup == nil:
+		return finalize
+	case finalize == nil:
+		return dedup
+	}
+
+	return func(results []R) []R {
+		return finalize(dedup(results))
+	}
+}
+
+// WithSink hands every result to the sink as it arrives, instead of retaining it until Wait returns,
+// e.g. to stream the output of a long batch run to a file, see [JSONLines], to group it, see [Grouped],
+// or to hand it to several consumers, see [Broadcast].
+// Wait returns no results then.
+// The sink is called by a single goroutine per nursery, but by one per shard of a [Sharded] nursery.
+func WithSink[R any](sink func(result R)) Option {
+	if sink == nil {
+		panic("sink must not be nil")
+	}
+
+	return func(cfg *config) {
+		cfg.sink = sink
+	}
+}
+
+// WithSortedMerge makes a nursery, whose jobs each return a sorted slice, merge the slices while they are collected,
+// so Wait returns a single sorted slice, e.g. for workers sorting chunks of a larger input,
+// without a final sort. Slices are merged in O(n log k) for k slices with n elements in total,
+// and elements, that compare equal, keep the order they were collected in.
+// The results are neither streamed, handed to a sink, nor ordered, see [WithSubmissionOrder],
+// and a [Sharded] nursery returns a merged slice per shard, see [MergeSorted] to merge them.
+func WithSortedMerge[T any](compare func(a, b T) int) Option {
+	if compare == nil {
+		panic("compare must not be nil")
+	}
+
+	return func(cfg *config) {
+		cfg.merger = func() merger[[]T] {
+			return &sortedRuns[T]{stack: nil, compare: compare}
+		}
+	}
+}
+
+// WithRouter hands every result to the sink named by route as it arrives, instead of retaining it,
+// e.g. to collect results needing review apart from the others, without partitioning them once Wait returns.
+// Results routed to a name without a sink are handed to the sink of [WithSink], if there is one,
+// or retained, so Wait returns them.
+// The sinks are called like the one of [WithSink], e.g. a [GroupSink] or a [BroadcastSink] may be used.
+func WithRouter[R any](route func(result R) string, sinks map[string]func(result R)) Option {
+	if route == nil {
+		panic("route must not be nil")
+	}
+
+	for name, sink := range sinks {
+		if sink == nil {
+			panic(fmt.Sprintf("sink %q must not be nil", name))
+		}
+	}
+
+	sinks = maps.Clone(sinks)
+
+	return func(cfg *config) {
+		cfg.router = func(result R) bool {
+			sink, ok := sinks[route(result)]
+			if ok {
+				sink(result)
+			}
+
+			return ok
+		}
+	}
+}
+
+// hook asserts a hook configured as any to the type required by the nursery.
+func hook[F any](value any, option string) F {
+	if value == nil {
+		var zero F
+
+		return zero
+	}
+
+	typed, ok := value.(F)
+	if !ok {
+		panic(fmt.Sprintf("%s expects %T, but the nursery requires %s", option, value, reflect.TypeFor[F]()))
+	}
+
+	return typed
+}
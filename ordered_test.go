@@ -0,0 +1,49 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithSubmissionOrder(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 4, nursery.WithSubmissionOrder())
+
+	for i := range 8 {
+		bounded.Go(func() int {
+			// Later jobs finish first.
+			time.Sleep(time.Duration(8-i) * time.Millisecond)
+
+			return i
+		})
+	}
+
+	if results := bounded.Wait(); !slices.Equal(results, []int{0, 1, 2, 3, 4, 5, 6, 7}) {
+		t.Fatalf("expected the results in submission order, got %v", results)
+	}
+}
+
+func TestWithSubmissionOrder_BeforeFinalizer(t *testing.T) {
+	t.Parallel()
+
+	results := nursery.WithUnbounded(func(Go nursery.Go[int]) {
+		for i := range 4 {
+			Go(func() int {
+				time.Sleep(time.Duration(4-i) * time.Millisecond)
+
+				return i
+			})
+		}
+	}, nursery.WithSubmissionOrder(), nursery.WithFinalizer(func(results []int) []int {
+		return results[:2]
+	}))
+
+	if !slices.Equal(results, []int{0, 1}) {
+		t.Fatalf("expected the finalizer to see the ordered results, got %v", results)
+	}
+}
@@ -0,0 +1,73 @@
+package nursery
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// guard runs the job, and recovers its panic as [PanicError], which is returned and kept for Wait to rethrow.
+// Only the first panic of the nursery is kept.
+func (nursery *Unbounded[R]) guard(info JobInfo, job func() R) (result R, panicked *PanicError) {
+	defer func() {
+		if value := recover(); value != nil {
+			panicked = &PanicError{
+				Value:    value,
+				Stack:    captureStack(nursery.stack),
+				JobIndex: info.Index,
+				Name:     info.Name,
+			}
+			nursery.panicked.CompareAndSwap(nil, panicked)
+		}
+	}()
+
+	return labeled(info, job), nil
+}
+
+// rethrow panics with the first panic of a job, if any job panicked.
+func (nursery *Unbounded[R]) rethrow() {
+	if panicked := nursery.panicked.Load(); panicked != nil {
+		panic(panicked)
+	}
+}
+
+// PanicError reports a panic of a job, so it can flow through the same paths as ordinary errors,
+// while remaining distinguishable via [errors.As].
+// Stack is the stack trace of the panicking goroutine,
+// JobIndex the position of the job in submission order, starting with 0,
+// and Name the name of the job, if it was started with a name, e.g. via [Unbounded.GoNamed].
+type PanicError struct {
+	Value    any
+	Stack    []byte
+	JobIndex int
+	Name     string
+}
+
+func (err *PanicError) Error() string {
+	job := JobInfo{Index: err.JobIndex, Tag: "", Name: err.Name}
+
+	return fmt.Sprintf("%s panicked: %v\n\n%s", job, err.Value, err.Stack)
+}
+
+// Unwrap returns the panic value, if it is an error.
+func (err *PanicError) Unwrap() error {
+	if cause, ok := err.Value.(error); ok {
+		return cause
+	}
+
+	return nil
+}
+
+// rescue recovers the panic of a wait in a background goroutine, keeping only the first one,
+// so the caller waiting for the goroutine can rethrow it via reraise. It must be deferred directly.
+func rescue(panicked *atomic.Pointer[any]) {
+	if value := recover(); value != nil {
+		panicked.CompareAndSwap(nil, &value)
+	}
+}
+
+// reraise panics with the panic recovered by rescue, if any.
+func reraise(panicked *atomic.Pointer[any]) {
+	if value := panicked.Load(); value != nil {
+		panic(*value)
+	}
+}
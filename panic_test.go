@@ -0,0 +1,114 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestPanicError(t *testing.T) {
+	t.Parallel()
+
+	var err error = fmt.Errorf("wrapped: %w",
+		&nursery.PanicError{Value: io.EOF, Stack: []byte("stack"), JobIndex: 3, Name: ""})
+
+	var panicErr *nursery.PanicError
+	if !errors.As(err, &panicErr) || panicErr.JobIndex != 3 {
+		t.Fatalf("expected a panic error, got %v", err)
+	}
+
+	if !errors.Is(err, io.EOF) {
+		t.Fatalf("expected the panic value to be unwrapped, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "job 3 panicked: EOF") || !strings.Contains(err.Error(), "stack") {
+		t.Fatalf("expected value and stack in the message, got %q", err.Error())
+	}
+}
+
+func TestUnbounded_RethrowsPanic(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int](nursery.WithStackCapture(nursery.StackTrimmed))
+	unbounded.Go(func() int { return 1 })
+	unbounded.Go(func() int { panic(io.EOF) })
+
+	<-unbounded.Done()
+
+	panicErr := waitPanic(t, func() { unbounded.Wait() })
+
+	if panicErr.JobIndex != 1 || !errors.Is(panicErr, io.EOF) || len(panicErr.Stack) == 0 {
+		t.Fatalf("expected the panic of the second job, got %v", panicErr)
+	}
+
+	if stats := unbounded.Stats(); stats.Completed != 1 || stats.Results != 1 {
+		t.Fatalf("expected only the other job to complete, got %+v", stats)
+	}
+}
+
+func TestBounded_CancelsOnPanic(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1)
+	bounded.Go(func() int { panic("boom") })
+
+	ran := false
+
+	bounded.Go(func() int {
+		ran = true
+
+		return 1
+	})
+
+	panicErr := waitPanic(t, func() { bounded.Wait() })
+
+	if panicErr.Value != "boom" || ran || !errors.Is(bounded.Token().Reason(), panicErr) {
+		t.Fatalf("expected the nursery to be cancelled by the panic, got %v", bounded.Token().Reason())
+	}
+}
+
+func TestMerge_RethrowsPanic(t *testing.T) {
+	t.Parallel()
+
+	failing := nursery.NewUnbounded[int]()
+	failing.Go(func() int { panic("boom") })
+
+	panicErr := waitPanic(t, func() { nursery.Merge[int](nursery.NewUnbounded[int](), failing) })
+
+	if panicErr.Value != "boom" {
+		t.Fatalf("expected the panic of the failing source, got %v", panicErr)
+	}
+}
+
+func TestPanicError_Name(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBoundedSimple[int](1, nursery.WithStackCapture(nursery.StackNone))
+	bounded.GoNamed("parse", func() int { panic(io.EOF) })
+
+	panicErr := waitPanic(t, func() { bounded.Wait() })
+	if panicErr.Name != "parse" || !strings.HasPrefix(panicErr.Error(), `job "parse" panicked: EOF`) {
+		t.Fatalf("expected the name of the job in the panic, got %q", panicErr.Error())
+	}
+}
+
+// waitPanic returns the panic of wait, which must be a [nursery.PanicError].
+func waitPanic(t *testing.T, wait func()) (panicErr *nursery.PanicError) {
+	t.Helper()
+
+	defer func() {
+		var ok bool
+		if panicErr, ok = recover().(*nursery.PanicError); !ok {
+			t.Fatalf("expected a panic error, got %v", panicErr)
+		}
+	}()
+
+	wait()
+
+	return nil
+}
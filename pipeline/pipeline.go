@@ -0,0 +1,195 @@
+// Package pipeline connects stages of jobs by channels, on an unbounded nursery,
+// so a pipeline is joined as a whole and the first error of a stage cancels all others.
+//
+// The buffer of the channel a stage writes to, and the number of workers of a stage,
+// are configured independently per stage, see [WithBuffer] and [WithConcurrency],
+// as mismatched throughput of the stages is the main knob to tune.
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+
+	"github.com/lukasngl/nursery"
+)
+
+// Pipeline runs the workers of its stages.
+// Stages must be added before [Pipeline.Wait] is called.
+type Pipeline struct {
+	nursery *nursery.Unbounded[error]
+	//nolint:containedctx // required for cancelling the stages
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	once   sync.Once
+	first  error
+}
+
+// New returns a pipeline, whose stages are cancelled once ctx is done.
+func New(ctx context.Context) *Pipeline {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	return &Pipeline{
+		nursery: nursery.NewUnbounded[error](),
+		ctx:     ctx,
+		cancel:  cancel,
+		once:    sync.Once{},
+		first:   nil,
+	}
+}
+
+// Context returns the context of the stages, which is cancelled by the first error of a stage.
+func (pipeline *Pipeline) Context() context.Context {
+	return pipeline.ctx
+}
+
+// Cancel stops all stages, e.g. once the consumer stopped reading the output of the last one.
+func (pipeline *Pipeline) Cancel() {
+	pipeline.cancel(context.Canceled)
+}
+
+// Wait blocks until the workers of all stages returned, and returns the first error of a stage.
+func (pipeline *Pipeline) Wait() error {
+	pipeline.nursery.Wait()
+	pipeline.cancel(nil)
+
+	return pipeline.first
+}
+
+// fail cancels all stages with the first error.
+func (pipeline *Pipeline) fail(err error) {
+	pipeline.once.Do(func() { pipeline.first = err })
+	pipeline.cancel(err)
+}
+
+// Option configures a stage.
+type Option func(*stage)
+
+type stage struct {
+	buffer      int
+	concurrency int
+}
+
+func newStage(opts []Option) stage {
+	stage := stage{buffer: 0, concurrency: 1}
+
+	for _, opt := range opts {
+		opt(&stage)
+	}
+
+	return stage
+}
+
+// WithBuffer sets the buffer size of the channel, a stage writes to, which is unbuffered by default.
+func WithBuffer(size int) Option {
+	if size < 0 {
+		panic(fmt.Sprintf("buffer size must not be negative, but was %d", size))
+	}
+
+	return func(stage *stage) {
+		stage.buffer = size
+	}
+}
+
+// WithConcurrency sets the number of workers of a stage, which is one by default, so the order is kept.
+// With more workers, the elements are written in the order they are finished instead.
+// It is ignored by sources, see [From].
+//
+//nolint:varnamelen // n is perfectly fine
+func WithConcurrency(n int) Option {
+	if n < 1 {
+		panic(fmt.Errorf("%w, but was %d", nursery.ErrBoundInvalid, n))
+	}
+
+	return func(stage *stage) {
+		stage.concurrency = n
+	}
+}
+
+// From adds a source stage, that writes the elements of seq to the returned channel.
+func From[T any](pipeline *Pipeline, seq iter.Seq[T], opts ...Option) <-chan T {
+	stage := newStage(opts)
+	out := make(chan T, stage.buffer)
+
+	pipeline.nursery.Go(func() error {
+		defer close(out)
+
+		for element := range seq {
+			select {
+			case out <- element:
+			case <-pipeline.ctx.Done():
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	return out
+}
+
+// Stage adds a stage, that writes f applied to every element read from in to the returned channel.
+// Once f fails, all stages are cancelled and the error is returned by [Pipeline.Wait].
+func Stage[A, B any](
+	pipeline *Pipeline,
+	in <-chan A,
+	f func(ctx context.Context, element A) (B, error),
+	opts ...Option,
+) <-chan B {
+	if f == nil {
+		panic("f must not be nil")
+	}
+
+	stage := newStage(opts)
+	out := make(chan B, stage.buffer)
+
+	var workers sync.WaitGroup
+
+	for range stage.concurrency {
+		workers.Add(1)
+
+		pipeline.nursery.Go(func() error {
+			defer workers.Done()
+
+			for {
+				var (
+					element A
+					open    bool
+				)
+
+				select {
+				case element, open = <-in:
+				case <-pipeline.ctx.Done():
+					return nil
+				}
+
+				if !open {
+					return nil
+				}
+
+				result, err := f(pipeline.ctx, element)
+				if err != nil {
+					pipeline.fail(err)
+
+					return err
+				}
+
+				select {
+				case out <- result:
+				case <-pipeline.ctx.Done():
+					return nil
+				}
+			}
+		})
+	}
+
+	pipeline.nursery.Go(func() error {
+		workers.Wait()
+		close(out)
+
+		return nil
+	})
+
+	return out
+}
@@ -0,0 +1,151 @@
+package pipeline_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery/pipeline"
+)
+
+var errOdd = errors.New("odd")
+
+func TestStage_KeepsOrder(t *testing.T) {
+	t.Parallel()
+
+	stages := pipeline.New(context.Background())
+
+	numbers := pipeline.From(stages, slices.Values([]int{1, 2, 3, 4}), pipeline.WithBuffer(4))
+	squares := pipeline.Stage(stages, numbers, func(_ context.Context, n int) (int, error) {
+		return n * n, nil
+	})
+
+	var got []int
+	for square := range squares {
+		got = append(got, square)
+	}
+
+	if err := stages.Wait(); err != nil || !slices.Equal(got, []int{1, 4, 9, 16}) {
+		t.Fatalf("expected the squares in order without error, got %v and %v", got, err)
+	}
+}
+
+func TestStage_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	var running, peak atomic.Int64
+
+	stages := pipeline.New(context.Background())
+
+	numbers := pipeline.From(stages, slices.Values(make([]int, 20)))
+	slow := pipeline.Stage(stages, numbers, func(_ context.Context, n int) (int, error) {
+		current := running.Add(1)
+		defer running.Add(-1)
+
+		for previous := peak.Load(); current > previous && !peak.CompareAndSwap(previous, current); {
+			previous = peak.Load()
+		}
+
+		time.Sleep(time.Millisecond)
+
+		return n, nil
+	}, pipeline.WithConcurrency(3), pipeline.WithBuffer(1))
+
+	count := 0
+	for range slow {
+		count++
+	}
+
+	if err := stages.Wait(); err != nil || count != 20 || peak.Load() != 3 {
+		t.Fatalf("expected 20 elements with 3 workers in parallel, got %d, %d and %v", count, peak.Load(), err)
+	}
+}
+
+func TestStage_Buffer(t *testing.T) {
+	t.Parallel()
+
+	stages := pipeline.New(context.Background())
+	defer func() { _ = stages.Wait() }()
+
+	numbers := pipeline.From(stages, slices.Values([]int{1, 2, 3}))
+	buffered := pipeline.Stage(stages, numbers, func(_ context.Context, n int) (int, error) {
+		return n, nil
+	}, pipeline.WithBuffer(3))
+
+	// Without reading, the stage fills its buffer and closes it.
+	deadline := time.Now().Add(time.Second)
+	for len(buffered) < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	if len(buffered) != 3 || cap(buffered) != 3 {
+		t.Fatalf("expected a full buffer of 3, got %d of %d", len(buffered), cap(buffered))
+	}
+
+	for range buffered { //nolint:revive // drain the stage
+	}
+}
+
+func TestStage_ErrorCancels(t *testing.T) {
+	t.Parallel()
+
+	naturals := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	stages := pipeline.New(context.Background())
+
+	numbers := pipeline.From(stages, naturals)
+	checked := pipeline.Stage(stages, numbers, func(_ context.Context, n int) (int, error) {
+		if n == 5 {
+			return 0, errOdd
+		}
+
+		return n, nil
+	}, pipeline.WithConcurrency(2))
+
+	for range checked { //nolint:revive // drain the stage
+	}
+
+	if err := stages.Wait(); !errors.Is(err, errOdd) {
+		t.Fatalf("expected the error of the stage, got %v", err)
+	}
+
+	if !errors.Is(context.Cause(stages.Context()), errOdd) {
+		t.Fatalf("expected the stages to be cancelled by the error, got %v", context.Cause(stages.Context()))
+	}
+}
+
+func TestPipeline_Cancel(t *testing.T) {
+	t.Parallel()
+
+	stages := pipeline.New(context.Background())
+
+	numbers := pipeline.From(stages, slices.Values(make([]int, 100)))
+
+	<-numbers
+	stages.Cancel()
+
+	if err := stages.Wait(); err != nil {
+		t.Fatalf("expected no error after cancelling, got %v", err)
+	}
+}
+
+func TestWithConcurrency_Invalid(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic")
+		}
+	}()
+
+	pipeline.WithConcurrency(0)
+}
@@ -0,0 +1,172 @@
+package nursery
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// pool runs tasks on worker goroutines, that are reused for subsequent tasks.
+// Workers without a task wait for the idle timeout to pass before they exit.
+type pool struct {
+	mx      sync.Mutex
+	closed  bool
+	timeout time.Duration
+	// idle holds the task channels of the workers waiting for a task.
+	idle    []chan func()
+	workers sync.WaitGroup
+	// created counts the started workers, reused the tasks handed to idle ones.
+	created atomic.Int64
+	reused  atomic.Int64
+	// init and teardown are run by every worker, when it starts and exits, if they are set.
+	init     func()
+	teardown func()
+}
+
+func newPool(timeout time.Duration) *pool {
+	return &pool{
+		mx:       sync.Mutex{},
+		closed:   false,
+		timeout:  timeout,
+		idle:     nil,
+		workers:  sync.WaitGroup{},
+		created:  atomic.Int64{},
+		reused:   atomic.Int64{},
+		init:     nil,
+		teardown: nil,
+	}
+}
+
+// start runs the task on an idle worker, or on a new one if there is none.
+// It never blocks.
+func (pool *pool) start(task func()) {
+	pool.mx.Lock()
+
+	if last := len(pool.idle) - 1; last >= 0 {
+		tasks := pool.idle[last]
+		pool.idle = pool.idle[:last]
+		pool.mx.Unlock()
+
+		pool.reused.Add(1)
+		tasks <- task
+
+		return
+	}
+
+	pool.mx.Unlock()
+
+	pool.workers.Add(1)
+	pool.created.Add(1)
+
+	go pool.work(make(chan func(), 1), task)
+}
+
+// prewarm starts the given number of idle workers, that wait for their first task until the pool is closed.
+func (pool *pool) prewarm(count int) {
+	pool.mx.Lock()
+	defer pool.mx.Unlock()
+
+	for range count {
+		// Buffered, so that start never blocks.
+		tasks := make(chan func(), 1)
+		pool.idle = append(pool.idle, tasks)
+		pool.workers.Add(1)
+		pool.created.Add(1)
+
+		go pool.work(tasks, nil)
+	}
+}
+
+// first waits for the first task of a pre-warmed worker.
+func (pool *pool) first(tasks chan func()) func() {
+	return <-tasks
+}
+
+// work runs the task and subsequent ones sent on tasks, which must be buffered so that start never blocks.
+// Pre-warmed workers start without a task and wait for their first one.
+func (pool *pool) work(tasks chan func(), task func()) {
+	defer pool.workers.Done()
+
+	if pool.init != nil {
+		pool.init()
+	}
+
+	if pool.teardown != nil {
+		defer pool.teardown()
+	}
+
+	if task == nil {
+		task = pool.first(tasks)
+	}
+
+	for task != nil {
+		task()
+
+		task = pool.next(tasks)
+	}
+}
+
+// next waits for the next task of the worker, or returns nil if the worker should exit.
+func (pool *pool) next(tasks chan func()) func() {
+	if pool.timeout <= 0 {
+		return nil
+	}
+
+	pool.mx.Lock()
+
+	if pool.closed {
+		pool.mx.Unlock()
+
+		return nil
+	}
+
+	pool.idle = append(pool.idle, tasks)
+	pool.mx.Unlock()
+
+	timer := time.NewTimer(pool.timeout)
+	defer timer.Stop()
+
+	select {
+	case task := <-tasks:
+		return task
+	case <-timer.C:
+		if pool.retire(tasks) {
+			return nil
+		}
+
+		// Someone picked the worker in the meantime.
+		return <-tasks
+	}
+}
+
+// retire removes the worker from the idle workers and reports whether it was still idle.
+func (pool *pool) retire(tasks chan func()) bool {
+	pool.mx.Lock()
+	defer pool.mx.Unlock()
+
+	for i, idle := range pool.idle {
+		if idle == tasks {
+			pool.idle = append(pool.idle[:i], pool.idle[i+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// close makes all idle workers exit and waits for all workers to finish.
+func (pool *pool) close() {
+	pool.mx.Lock()
+
+	pool.closed = true
+
+	for _, tasks := range pool.idle {
+		close(tasks)
+	}
+
+	pool.idle = nil
+	pool.mx.Unlock()
+
+	pool.workers.Wait()
+}
@@ -0,0 +1,90 @@
+package nursery
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPool_ReusesIdleWorkers(t *testing.T) {
+	t.Parallel()
+
+	pool := newPool(time.Hour)
+	defer pool.close()
+
+	done := make(chan struct{})
+
+	pool.start(func() { done <- struct{}{} })
+	<-done
+	waitForIdle(pool, 1)
+
+	pool.start(func() { done <- struct{}{} })
+	<-done
+	waitForIdle(pool, 1)
+}
+
+func TestPool_IdleWorkersExitAfterTimeout(t *testing.T) {
+	t.Parallel()
+
+	pool := newPool(time.Millisecond)
+
+	pool.start(func() {})
+	pool.start(func() {})
+
+	// close waits for all workers, so idle ones must have exited on their own.
+	time.Sleep(20 * time.Millisecond)
+
+	pool.mx.Lock()
+	idle := len(pool.idle)
+	pool.mx.Unlock()
+
+	if idle != 0 {
+		t.Fatalf("expected idle workers to exit, but %d are left", idle)
+	}
+
+	pool.close()
+}
+
+func TestPool_CloseStopsIdleWorkers(t *testing.T) {
+	t.Parallel()
+
+	pool := newPool(time.Hour)
+
+	pool.start(func() {})
+	waitForIdle(pool, 1)
+
+	// Would block for an hour, if idle workers were not stopped.
+	pool.close()
+}
+
+func TestPool_PrewarmedWorkersAreIdle(t *testing.T) {
+	t.Parallel()
+
+	pool := newPool(0)
+	pool.prewarm(3)
+
+	waitForIdle(pool, 3)
+
+	done := make(chan struct{})
+
+	pool.start(func() { close(done) })
+	<-done
+
+	// The worker exits after its first task, as there is no idle timeout.
+	waitForIdle(pool, 2)
+	pool.close()
+}
+
+// waitForIdle waits until the given number of workers is idle.
+func waitForIdle(pool *pool, count int) {
+	for {
+		pool.mx.Lock()
+		idle := len(pool.idle)
+		pool.mx.Unlock()
+
+		if idle == count {
+			return
+		}
+
+		time.Sleep(time.Microsecond)
+	}
+}
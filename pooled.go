@@ -0,0 +1,103 @@
+package nursery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Pooled is a [Bounded] nursery, whose workers each own a resource, e.g. a database connection or a parser,
+// that is handed to every job the worker runs, instead of constructing it per job.
+// A resource is only used by one job at a time.
+type Pooled[T, R any] struct {
+	bounded *Bounded[R]
+	// resources holds the resource per slot of the nursery.
+	resources []T
+	destroy   func(T) error
+	destroyed sync.Once
+	err       error
+}
+
+// NewPooled returns a new nursery, that executes at most n jobs in parallel,
+// and creates a resource per slot via create.
+// If any resource cannot be created, the ones created so far are destroyed and the error is returned.
+// Resources are destroyed via destroy, which may be nil, once Wait returns.
+//
+//nolint:varnamelen // n is perfectly fine
+func NewPooled[T, R any](
+	ctx context.Context,
+	n int,
+	create func() (T, error),
+	destroy func(resource T) error,
+	opts ...Option,
+) (*Pooled[T, R], error) {
+	if create == nil {
+		panic("create must not be nil")
+	}
+
+	resources := make([]T, 0, max(n, 0))
+
+	for range n {
+		resource, err := create()
+		if err != nil {
+			err = fmt.Errorf("creating resource %d: %w", len(resources), err)
+
+			return nil, errors.Join(err, destroyAll(resources, destroy))
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return &Pooled[T, R]{
+		bounded:   NewBounded[R](ctx, n, opts...),
+		resources: resources,
+		destroy:   destroy,
+		destroyed: sync.Once{},
+		err:       nil,
+	}, nil
+}
+
+// Go runs the job in the background with the resource of the slot it got, and collects its result.
+func (nursery *Pooled[T, R]) Go(job func(resource T) R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.bounded.submit(nursery.bounded.slots, JobInfo{Index: 0, Tag: "", Name: ""}, func(attempt *Attempt) R {
+		return job(nursery.resources[attempt.lane])
+	})
+}
+
+// Wait blocks until all jobs are finished, destroys the resources,
+// and returns the collected results together with the errors of destroying them.
+// Subsequent calls return the same results and error.
+// If a job panicked, the resources are destroyed before the panic is rethrown, see [Bounded.Wait].
+func (nursery *Pooled[T, R]) Wait() ([]R, error) {
+	results := nursery.bounded.wait()
+
+	nursery.destroyed.Do(func() {
+		nursery.err = destroyAll(nursery.resources, nursery.destroy)
+	})
+
+	nursery.bounded.inner.rethrow()
+
+	return results, nursery.err
+}
+
+// destroyAll destroys the resources and joins their errors.
+func destroyAll[T any](resources []T, destroy func(T) error) error {
+	if destroy == nil {
+		return nil
+	}
+
+	errs := make([]error, 0, len(resources))
+
+	for i, resource := range resources {
+		if err := destroy(resource); err != nil {
+			errs = append(errs, fmt.Errorf("destroying resource %d: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
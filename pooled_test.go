@@ -0,0 +1,114 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+// resource tracks, whether it is used by a job and whether it was destroyed.
+type resource struct {
+	id        int
+	inUse     atomic.Bool
+	destroyed atomic.Bool
+}
+
+func TestPooled_ReusesResourcePerWorker(t *testing.T) {
+	t.Parallel()
+
+	var created []*resource
+
+	pooled, err := nursery.NewPooled[*resource, int](context.TODO(), 3, func() (*resource, error) {
+		created = append(created, &resource{id: len(created), inUse: atomic.Bool{}, destroyed: atomic.Bool{}})
+
+		return created[len(created)-1], nil
+	}, func(resource *resource) error {
+		resource.destroyed.Store(true)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var shared atomic.Int64
+
+	for range 30 {
+		pooled.Go(func(resource *resource) int {
+			if !resource.inUse.CompareAndSwap(false, true) {
+				shared.Add(1)
+			}
+			defer resource.inUse.Store(false)
+
+			time.Sleep(100 * time.Microsecond)
+
+			return resource.id
+		})
+	}
+
+	results, err := pooled.Wait()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(created) != 3 || len(results) != 30 || shared.Load() != 0 {
+		t.Fatalf("expected 3 exclusively used resources for 30 jobs, got %d for %v", len(created), results)
+	}
+
+	for _, resource := range created {
+		if !resource.destroyed.Load() || !slices.Contains(results, resource.id) {
+			t.Fatalf("expected resource %d to be used and destroyed", resource.id)
+		}
+	}
+}
+
+func TestNewPooled_DestroysCreatedOnError(t *testing.T) {
+	t.Parallel()
+
+	errCreate := errors.New("no connection")
+	created, destroyed := 0, 0
+
+	_, err := nursery.NewPooled[int, int](context.TODO(), 3, func() (int, error) {
+		if created == 2 {
+			return 0, errCreate
+		}
+
+		created++
+
+		return created, nil
+	}, func(int) error {
+		destroyed++
+
+		return nil
+	})
+
+	if !errors.Is(err, errCreate) || destroyed != 2 {
+		t.Fatalf("expected the created resources to be destroyed, got %d destroyed and %v", destroyed, err)
+	}
+}
+
+func TestPooled_JoinsDestroyErrors(t *testing.T) {
+	t.Parallel()
+
+	errDestroy := errors.New("closing failed")
+
+	pooled, err := nursery.NewPooled[int, int](context.TODO(), 2, func() (int, error) {
+		return 0, nil
+	}, func(int) error {
+		return errDestroy
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pooled.Go(func(resource int) int { return resource })
+
+	if results, err := pooled.Wait(); len(results) != 1 || !errors.Is(err, errDestroy) {
+		t.Fatalf("expected the result and the error of destroying, got %v and %v", results, err)
+	}
+}
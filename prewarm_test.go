@@ -0,0 +1,22 @@
+package nursery_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithBounded_Prewarm(t *testing.T) {
+	t.Parallel()
+
+	results := nursery.WithBounded(context.TODO(), 3, func(Go nursery.Go[int]) {
+		for job := range 10 {
+			Go(func() int { return job })
+		}
+	}, nursery.WithPrewarm())
+
+	if len(results) != 10 {
+		t.Fatalf("expected all 10 jobs to complete, got %v", results)
+	}
+}
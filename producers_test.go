@@ -0,0 +1,77 @@
+package nursery_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestUnbounded_ConcurrentProducers(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+
+	if results := produceConcurrently(unbounded.Go, unbounded.Wait); len(results) != producers*jobsPerProducer {
+		t.Fatalf("expected %d results, got %d", producers*jobsPerProducer, len(results))
+	}
+}
+
+func TestBounded_ConcurrentProducers(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 3)
+
+	if results := produceConcurrently(bounded.Go, bounded.Wait); len(results) != producers*jobsPerProducer {
+		t.Fatalf("expected %d results, got %d", producers*jobsPerProducer, len(results))
+	}
+}
+
+func TestUnbounded_GoRacingWait(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+	accepted := make(chan bool)
+
+	go func() {
+		defer func() {
+			if recover() != nil {
+				accepted <- false
+			}
+		}()
+
+		unbounded.Go(func() int { return 1 })
+		accepted <- true
+	}()
+
+	results := unbounded.Wait()
+
+	// Either the job was accepted and is awaited, or the submission panicked.
+	if <-accepted != (len(results) == 1) {
+		t.Fatalf("expected accepted jobs to be awaited, got %v", results)
+	}
+}
+
+const producers, jobsPerProducer = 8, 50
+
+// produceConcurrently submits jobs from several goroutines and waits for them.
+func produceConcurrently(Go nursery.Go[int], wait func() []int) []int {
+	var submitting sync.WaitGroup
+
+	for range producers {
+		submitting.Add(1)
+
+		go func() {
+			defer submitting.Done()
+
+			for job := range jobsPerProducer {
+				Go(func() int { return job })
+			}
+		}()
+	}
+
+	submitting.Wait()
+
+	return wait()
+}
@@ -0,0 +1,71 @@
+package nursery
+
+import "time"
+
+// Progress is a snapshot of how many of the jobs submitted so far are done, see [Unbounded.ProgressTicker].
+// Done jobs include skipped ones, Rate is the number of jobs done per second since the previous snapshot.
+type Progress struct {
+	Done  int64
+	Total int64
+	Rate  float64
+}
+
+// ProgressTicker returns a channel, that receives a [Progress] snapshot every interval,
+// and a final one once Wait returns, after which it is closed.
+// Like a [time.Ticker], snapshots are dropped, while the receiver is not ready, so it never holds back the nursery.
+func (nursery *Unbounded[R]) ProgressTicker(interval time.Duration) <-chan Progress {
+	if interval <= 0 {
+		panic("progress interval must be positive")
+	}
+
+	nursery.init()
+
+	ticks := make(chan Progress, 1)
+
+	go func() {
+		defer close(ticks)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		last, since := int64(0), time.Now()
+
+		snapshot := func() Progress {
+			total := int64(nursery.ids.Load())
+			done := max(total-nursery.active.Load(), 0)
+			now := time.Now()
+
+			progress := Progress{Done: done, Total: total, Rate: float64(done-last) / now.Sub(since).Seconds()}
+			last, since = done, now
+
+			return progress
+		}
+
+		for {
+			select {
+			case <-nursery.finished:
+				// Replace a snapshot, that was not received yet, by the final one.
+				select {
+				case <-ticks:
+				default:
+				}
+
+				ticks <- snapshot()
+
+				return
+			case <-ticker.C:
+				select {
+				case ticks <- snapshot():
+				default:
+				}
+			}
+		}
+	}()
+
+	return ticks
+}
+
+// ProgressTicker is like [Unbounded.ProgressTicker].
+func (nursery *Bounded[R]) ProgressTicker(interval time.Duration) <-chan Progress {
+	return nursery.inner.ProgressTicker(interval)
+}
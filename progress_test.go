@@ -0,0 +1,46 @@
+package nursery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBounded_ProgressTicker(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 2)
+	ticks := bounded.ProgressTicker(time.Millisecond)
+
+	for job := range 20 {
+		bounded.Go(func() int {
+			time.Sleep(time.Millisecond)
+
+			return job
+		})
+	}
+
+	go bounded.Wait()
+
+	var snapshots []nursery.Progress
+
+	for progress := range ticks {
+		snapshots = append(snapshots, progress)
+	}
+
+	if len(snapshots) < 2 {
+		t.Fatalf("expected periodic snapshots, got %v", snapshots)
+	}
+
+	for i, progress := range snapshots[1:] {
+		if progress.Done < snapshots[i].Done {
+			t.Fatalf("expected progress to grow, got %v", snapshots)
+		}
+	}
+
+	if final := snapshots[len(snapshots)-1]; final.Done != 20 || final.Total != 20 {
+		t.Fatalf("expected a final snapshot with all jobs done, got %+v", final)
+	}
+}
@@ -0,0 +1,115 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+// fillQueue submits a running and a queued job to a nursery with a single slot and a queue of one,
+// which are released by the returned function.
+func fillQueue(t *testing.T, policy nursery.QueuePolicy) (*nursery.Bounded[int], func()) {
+	t.Helper()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithQueueLimit(1, policy))
+
+	started, release := make(chan struct{}), make(chan struct{})
+
+	bounded.Go(func() int {
+		close(started)
+		<-release
+
+		return 1
+	})
+	bounded.Go(func() int { return 2 })
+
+	<-started
+
+	return bounded, func() { close(release) }
+}
+
+func TestWithQueueLimit_Reject(t *testing.T) {
+	t.Parallel()
+
+	bounded, release := fillQueue(t, nursery.QueueReject)
+
+	if err, _ := recovered(func() { bounded.Go(func() int { return 3 }) }).(error); !errors.Is(err, nursery.ErrQueueFull) {
+		t.Fatalf("expected Go to panic with a full queue, got %v", err)
+	}
+
+	release()
+
+	if results := bounded.Wait(); len(results) != 2 {
+		t.Fatalf("expected only the accepted jobs to run, got %v", results)
+	}
+}
+
+func TestWithQueueLimit_Drop(t *testing.T) {
+	t.Parallel()
+
+	bounded, release := fillQueue(t, nursery.QueueDrop)
+
+	bounded.Go(func() int { return 3 })
+
+	if future := bounded.GoFuture(func(context.Context) int { return 4 }); !errors.Is(future.Err(), nursery.ErrSkipped) {
+		t.Fatalf("expected the future of a dropped job to be skipped, got %v", future.Err())
+	}
+
+	release()
+
+	if results := bounded.Wait(); len(results) != 2 {
+		t.Fatalf("expected the dropped jobs not to run, got %v", results)
+	}
+}
+
+func TestWithQueueLimit_TryGoDoesNotBlock(t *testing.T) {
+	t.Parallel()
+
+	bounded, release := fillQueue(t, nursery.QueueBlock)
+
+	if err := bounded.TryGo(func() int { return 3 }); !errors.Is(err, nursery.ErrQueueFull) {
+		t.Fatalf("expected TryGo to report the full queue, got %v", err)
+	}
+
+	release()
+
+	if results := bounded.Wait(); len(results) != 2 {
+		t.Fatalf("expected only the accepted jobs to run, got %v", results)
+	}
+
+	if err := bounded.TryGo(func() int { return 3 }); !errors.Is(err, nursery.ErrClosed) {
+		t.Fatalf("expected a closed nursery to reject jobs, got %v", err)
+	}
+}
+
+func TestWithQueueLimit_RoomOnceFinished(t *testing.T) {
+	t.Parallel()
+
+	bounded, release := fillQueue(t, nursery.QueueReject)
+	release()
+
+	// The queue has room again, once no job is active anymore.
+	for stats := bounded.Stats(); stats.Running+stats.Queued > 0; stats = bounded.Stats() {
+		runtime.Gosched()
+	}
+
+	if err := bounded.TryGo(func() int { return 3 }); err != nil {
+		t.Fatalf("expected room for another job, once the others finished, got %v", err)
+	}
+
+	if results := bounded.Wait(); len(results) != 3 {
+		t.Fatalf("expected all jobs to run, got %v", results)
+	}
+}
+
+func TestWithQueueLimit_Negative(t *testing.T) {
+	t.Parallel()
+
+	message := recovered(func() { nursery.WithQueueLimit(-1, nursery.QueueBlock) })
+	if message != "queue limit must not be negative, but was -1" {
+		t.Fatalf("expected a negative limit to panic, got %q", message)
+	}
+}
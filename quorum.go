@@ -0,0 +1,102 @@
+package nursery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+var (
+	// ErrQuorumUnreachable is the reason of a [Token], and the cause of the jobs' context,
+	// once a nursery was cancelled, as its quorum could not be reached anymore, see [WithQuorum].
+	ErrQuorumUnreachable = errors.New("quorum unreachable")
+	// ErrQuorumReached is the reason of a [Token], and the cause of the jobs' context,
+	// once a nursery was cancelled, as its quorum was reached, see [WithBoundedQuorum].
+	ErrQuorumReached = errors.New("quorum reached")
+)
+
+// tally counts the failure reported by the result, see [Bounded.reach].
+func (nursery *Bounded[R]) tally(result R) {
+	if nursery.quorum == 0 || errorOf(result) == nil {
+		return
+	}
+
+	nursery.reach(nursery.failed.Add(1))
+}
+
+// reach cancels the nursery, once the total number of jobs cannot reach the quorum anymore,
+// due to the given number of failures. It does nothing, as long as the total is unknown.
+func (nursery *Bounded[R]) reach(failed int64) {
+	total := nursery.total.Load()
+
+	if total > 0 && total-failed < int64(nursery.quorum) {
+		nursery.abort(ErrQuorumUnreachable)
+	}
+}
+
+// WithBoundedQuorum is like [WithBounded], but returns as soon as the given number of jobs succeeded,
+// e.g. for quorum reads against replicated backends, so the slowest replicas do not add to the latency.
+// The remaining jobs are cancelled like [Bounded.Cancel], with [ErrQuorumReached] as cause of the jobs' context,
+// which is handed to the closure, and waited for before WithBoundedQuorum returns.
+// Like with [WithQuorum], the nursery is cancelled early, once the quorum cannot be reached anymore,
+// where the total is the number of jobs started via Go, once run returned.
+//
+// It returns the results of the jobs, that succeeded, and an error wrapping [ErrQuorumUnreachable],
+// if they are too few.
+//
+//nolint:varnamelen // n is perfectly fine
+func WithBoundedQuorum[R any](
+	ctx context.Context,
+	successes, n int,
+	run func(ctx context.Context, Go Go[R]),
+	opts ...Option,
+) ([]R, error) {
+	if run == nil {
+		panic("run must not be nil")
+	}
+
+	if successes < 1 {
+		panic(fmt.Sprintf("quorum must be at least 1, but was %d", successes))
+	}
+
+	// The total number of jobs is unknown, until run returned.
+	nursery := NewBounded[R](ctx, n, append(opts, func(cfg *config) { cfg.quorum = successes })...)
+
+	var succeeded, submitted atomic.Int64
+
+	run(nursery.Context(), func(job func() R) {
+		if job == nil {
+			panic(nilJob())
+		}
+
+		submitted.Add(1)
+		nursery.Go(func() R {
+			result := job()
+			if errorOf(result) == nil && succeeded.Add(1) == int64(successes) {
+				nursery.abort(ErrQuorumReached)
+			}
+
+			return result
+		})
+	})
+
+	// Failures tallied while run was still submitting are checked against the total now.
+	nursery.total.Store(submitted.Load())
+	nursery.reach(nursery.failed.Load())
+
+	results := nursery.Wait()
+	quorum := make([]R, 0, successes)
+
+	for _, result := range results {
+		if errorOf(result) == nil {
+			quorum = append(quorum, result)
+		}
+	}
+
+	if len(quorum) < successes {
+		return quorum, fmt.Errorf("%w: %d of %d jobs succeeded", ErrQuorumUnreachable, len(quorum), successes)
+	}
+
+	return quorum, nil
+}
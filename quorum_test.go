@@ -0,0 +1,188 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithQuorum_CancelsOnceUnreachable(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[error](context.TODO(), 1, nursery.WithQuorum(3, 5))
+
+	ran := 0
+
+	for job := range 5 {
+		bounded.Go(func() error {
+			ran++
+
+			if job < 3 {
+				return errTransient
+			}
+
+			return nil
+		})
+	}
+
+	results := bounded.Wait()
+
+	if ran != 3 || len(results) != 3 {
+		t.Fatalf("expected the jobs to be cancelled after the third failure, but %d ran", ran)
+	}
+
+	if !errors.Is(bounded.Token().Reason(), nursery.ErrQuorumUnreachable) {
+		t.Fatalf("expected the quorum to be the reason, got %v", bounded.Token().Reason())
+	}
+
+	if !errors.Is(context.Cause(bounded.Context()), nursery.ErrQuorumUnreachable) {
+		t.Fatalf("expected the quorum to be the cause of the context, got %v", context.Cause(bounded.Context()))
+	}
+}
+
+func TestWithQuorum_ToleratesFailures(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[error](context.TODO(), 1, nursery.WithQuorum(3, 5))
+
+	for job := range 5 {
+		bounded.Go(func() error {
+			if job%2 == 0 {
+				return nil
+			}
+
+			return errTransient
+		})
+	}
+
+	if results := bounded.Wait(); len(results) != 5 || bounded.Token().Cancelled() {
+		t.Fatalf("expected all jobs to run, as the quorum is reachable, got %v", results)
+	}
+}
+
+func TestWithQuorum_EarlyFailure(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[error](context.TODO(), 3, nursery.WithQuorum(2, 3))
+
+	failed := make(chan struct{})
+
+	bounded.Go(func() error {
+		defer close(failed)
+
+		return errTransient
+	})
+
+	// The failure is tallied, before the remaining jobs are submitted.
+	<-failed
+
+	for bounded.Stats().Completed < 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	for range 2 {
+		bounded.Go(func() error { return nil })
+	}
+
+	if results := bounded.Wait(); len(results) != 3 || bounded.Token().Cancelled() {
+		t.Fatalf("expected all jobs to run, as the quorum is still reachable, got %v and %v",
+			results, bounded.Token().Reason())
+	}
+}
+
+func TestWithQuorum_Invalid(t *testing.T) {
+	t.Parallel()
+
+	if got := recovered(func() { nursery.WithQuorum(3, 2) }); got != "total must be at least the quorum of 3, but was 2" {
+		t.Fatalf("expected a panic for a total below the quorum, got %q", got)
+	}
+}
+
+func TestWithBoundedQuorum_ReturnsOnceReached(t *testing.T) {
+	t.Parallel()
+
+	results, err := nursery.WithBoundedQuorum(context.TODO(), 2, 5, func(ctx context.Context, Go nursery.Go[error]) {
+		Go(func() error { return nil })
+		Go(func() error { return errTransient })
+		Go(func() error { return nil })
+
+		for range 2 {
+			Go(func() error {
+				<-ctx.Done()
+
+				if !errors.Is(context.Cause(ctx), nursery.ErrQuorumReached) {
+					t.Errorf("expected the remaining jobs to be cancelled by the quorum, got %v", context.Cause(ctx))
+				}
+
+				return ctx.Err()
+			})
+		}
+	})
+	if err != nil {
+		t.Fatalf("expected the quorum to be reached, got %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected the results of the jobs, that succeeded, got %v", results)
+	}
+}
+
+func TestWithBoundedQuorum_Unreachable(t *testing.T) {
+	t.Parallel()
+
+	results, err := nursery.WithBoundedQuorum(context.TODO(), 2, 1, func(_ context.Context, Go nursery.Go[error]) {
+		Go(func() error { return nil })
+		Go(func() error { return errTransient })
+	})
+
+	if !errors.Is(err, nursery.ErrQuorumUnreachable) || len(results) != 1 {
+		t.Fatalf("expected the quorum to be unreachable, got %v, %v", results, err)
+	}
+}
+
+func TestWithBoundedQuorum_EarlyFailure(t *testing.T) {
+	t.Parallel()
+
+	results, err := nursery.WithBoundedQuorum(context.TODO(), 2, 3, func(_ context.Context, Go nursery.Go[error]) {
+		failed := make(chan struct{})
+
+		Go(func() error {
+			defer close(failed)
+
+			return errTransient
+		})
+
+		// The failure is tallied, before the remaining jobs are submitted.
+		<-failed
+		time.Sleep(time.Millisecond)
+
+		for range 2 {
+			Go(func() error { return nil })
+		}
+	})
+	if err != nil || len(results) != 2 {
+		t.Fatalf("expected the quorum to be reached despite the early failure, got %v and %v", results, err)
+	}
+}
+
+func TestWithBoundedQuorum_UnreachableOnceSubmitted(t *testing.T) {
+	t.Parallel()
+
+	results, err := nursery.WithBoundedQuorum(context.TODO(), 2, 3, func(ctx context.Context, Go nursery.Go[error]) {
+		Go(func() error { return errTransient })
+		Go(func() error { return errTransient })
+		Go(func() error {
+			// Only cancelled, once the failures are checked against the total.
+			<-ctx.Done()
+
+			return context.Cause(ctx)
+		})
+	})
+
+	if !errors.Is(err, nursery.ErrQuorumUnreachable) || len(results) != 0 {
+		t.Fatalf("expected the quorum to be unreachable, got %v and %v", results, err)
+	}
+}
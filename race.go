@@ -0,0 +1,83 @@
+package nursery
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrRaceWon is the cause of the jobs' context, once another job won the race, see [First].
+var ErrRaceWon = errors.New("race won by another job")
+
+// First runs all jobs in parallel, and returns the result of the first one that succeeds,
+// e.g. to hedge requests or race replicas.
+// The other jobs are cancelled via their context with [ErrRaceWon] as cause, or dropped if they did not start yet,
+// and First waits for them to return.
+// If no job succeeds, their errors are joined, together with the cause of ctx, if it was done before all jobs ran.
+func First[R any](ctx context.Context, jobs ...func(ctx context.Context) (R, error)) (R, error) {
+	if len(jobs) == 0 {
+		panic("jobs must not be empty")
+	}
+
+	for _, job := range jobs {
+		if job == nil {
+			panic(nilJob())
+		}
+	}
+
+	nursery := NewBounded[error](ctx, len(jobs))
+
+	var (
+		once   sync.Once
+		winner R
+		won    bool
+	)
+
+	for _, job := range jobs {
+		nursery.GoContext(func(ctx context.Context) error {
+			result, err := job(ctx)
+			if err != nil {
+				return err
+			}
+
+			once.Do(func() {
+				winner, won = result, true
+				nursery.abort(ErrRaceWon)
+			})
+
+			return nil
+		})
+	}
+
+	errs := nursery.Wait()
+
+	if won {
+		return winner, nil
+	}
+
+	if len(errs) < len(jobs) {
+		errs = append(errs, context.Cause(ctx))
+	}
+
+	return winner, errors.Join(errs...)
+}
+
+// Race is like [First], but for jobs that cannot fail: it returns the result of the first one that completes.
+// It only fails with the cause of ctx, if it was done before any job ran.
+func Race[R any](ctx context.Context, jobs ...func(ctx context.Context) R) (R, error) {
+	if len(jobs) == 0 {
+		panic("jobs must not be empty")
+	}
+
+	racing := make([]func(ctx context.Context) (R, error), len(jobs))
+
+	for i, job := range jobs {
+		if job == nil {
+			panic(nilJob())
+		}
+
+		racing[i] = func(ctx context.Context) (R, error) { return job(ctx), nil }
+	}
+
+	return First(ctx, racing...)
+}
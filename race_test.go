@@ -0,0 +1,86 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestFirst_ReturnsFirstSuccess(t *testing.T) {
+	t.Parallel()
+
+	var started, unwound atomic.Int64
+
+	slow := func(ctx context.Context) (int, error) {
+		started.Add(1)
+		<-ctx.Done()
+		unwound.Add(1)
+
+		if !errors.Is(context.Cause(ctx), nursery.ErrRaceWon) {
+			t.Errorf("expected the loser to be cancelled by the winner, got %v", context.Cause(ctx))
+		}
+
+		return 0, ctx.Err()
+	}
+	failing := func(context.Context) (int, error) { return 0, errTransient }
+	fast := func(context.Context) (int, error) { return 42, nil }
+
+	result, err := nursery.First(context.TODO(), slow, failing, slow, fast)
+	if err != nil || result != 42 {
+		t.Fatalf("expected the result of the succeeding job, got %d, %v", result, err)
+	}
+
+	// Losers, that did not start before the winner finished, are dropped.
+	if unwound.Load() != started.Load() {
+		t.Fatalf("expected the losers to unwind before First returned, but %d of %d did", unwound.Load(), started.Load())
+	}
+}
+
+func TestFirst_AllFail(t *testing.T) {
+	t.Parallel()
+
+	failing := func(context.Context) (int, error) { return 0, errTransient }
+
+	if _, err := nursery.First(context.TODO(), failing, failing); !errors.Is(err, errTransient) {
+		t.Fatalf("expected the errors of the jobs, got %v", err)
+	}
+}
+
+func TestFirst_ContextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancelCause(context.TODO())
+	cancel(errTransient)
+
+	job := func(context.Context) (int, error) { return 1, nil }
+
+	if _, err := nursery.First(ctx, job); !errors.Is(err, errTransient) {
+		t.Fatalf("expected the cause of the context, got %v", err)
+	}
+}
+
+func TestRace_ReturnsFirstCompleted(t *testing.T) {
+	t.Parallel()
+
+	slow := func(ctx context.Context) string {
+		<-ctx.Done()
+
+		return "slow"
+	}
+	fast := func(context.Context) string { return "fast" }
+
+	if result, err := nursery.Race(context.TODO(), slow, fast, slow); err != nil || result != "fast" {
+		t.Fatalf("expected the fast job to win, got %q, %v", result, err)
+	}
+}
+
+func TestFirst_NoJobs(t *testing.T) {
+	t.Parallel()
+
+	if message := recovered(func() { _, _ = nursery.First[int](context.TODO()) }); message != "jobs must not be empty" {
+		t.Fatalf("expected First without jobs to panic, got %q", message)
+	}
+}
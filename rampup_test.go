@@ -0,0 +1,75 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithBounded_RampUpStartsWithOneSlot(t *testing.T) {
+	t.Parallel()
+
+	var running, maxRunning atomic.Int32
+
+	nursery.WithBounded(context.TODO(), 4, func(Go nursery.Go[int]) {
+		for position := range 8 {
+			Go(func() int {
+				current := running.Add(1)
+				defer running.Add(-1)
+
+				for {
+					highest := maxRunning.Load()
+					if current <= highest || maxRunning.CompareAndSwap(highest, current) {
+						break
+					}
+				}
+
+				time.Sleep(time.Millisecond)
+
+				return position
+			})
+		}
+	}, nursery.WithRampUp(time.Hour))
+
+	if maxRunning.Load() != 1 {
+		t.Fatalf("expected a single job to run at a time, but %d ran in parallel", maxRunning.Load())
+	}
+}
+
+func TestWithBounded_RampUpReachesBound(t *testing.T) {
+	t.Parallel()
+
+	const bound = 4
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+
+	var running atomic.Int32
+
+	completed := nursery.WithBounded(ctx, bound, func(Go nursery.Go[bool]) {
+		for range bound {
+			Go(func() bool {
+				running.Add(1)
+
+				// Only returns true, if all jobs run in parallel eventually.
+				for running.Load() < bound {
+					select {
+					case <-ctx.Done():
+						return false
+					case <-time.After(time.Millisecond):
+					}
+				}
+
+				return true
+			})
+		}
+	}, nursery.WithRampUp(time.Millisecond))
+
+	if slices.Contains(completed, false) || len(completed) != bound {
+		t.Fatalf("expected all %d jobs to run in parallel, got %v", bound, completed)
+	}
+}
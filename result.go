@@ -0,0 +1,62 @@
+package nursery
+
+import "errors"
+
+// Result is the value of a job that may fail, like a [Tuple] with an error as last component,
+// but with helpers to split the results of a nursery, see [SplitResults] and [JoinErrors].
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// Ok returns a successful result.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{Value: value, Err: nil}
+}
+
+// Err returns a failed result.
+func Err[T any](err error) Result[T] {
+	var zero T
+
+	return Result[T]{Value: zero, Err: err}
+}
+
+// Unpack is like [Tuple.Unpack].
+func (result Result[T]) Unpack() (T, error) {
+	return result.Value, result.Err
+}
+
+// mapError replaces the error, if it is not nil, see [mapError].
+func (result Result[T]) mapError(mapping func(error) error) any {
+	result.Err = mapError(result.Err, mapping)
+
+	return result
+}
+
+// SplitResults returns the values of the successful results and the errors of the failed ones,
+// each in the order of the results.
+func SplitResults[T any](results []Result[T]) ([]T, []error) {
+	var (
+		values []T
+		errs   []error
+	)
+
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+
+			continue
+		}
+
+		values = append(values, result.Value)
+	}
+
+	return values, errs
+}
+
+// JoinErrors joins the errors of the failed results via [errors.Join], or returns nil if none failed.
+func JoinErrors[T any](results []Result[T]) error {
+	_, errs := SplitResults(results)
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,58 @@
+package nursery_test
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestResult_Unpack(t *testing.T) {
+	t.Parallel()
+
+	if value, err := nursery.Ok(1).Unpack(); value != 1 || err != nil {
+		t.Fatalf("expected the value without error, got %v and %v", value, err)
+	}
+
+	if value, err := nursery.Err[int](io.EOF).Unpack(); value != 0 || !errors.Is(err, io.EOF) {
+		t.Fatalf("expected the error with zero value, got %v and %v", value, err)
+	}
+}
+
+func TestSplitResults(t *testing.T) {
+	t.Parallel()
+
+	errUnexpected := errors.New("unexpected")
+
+	results := []nursery.Result[int]{
+		nursery.Ok(1), nursery.Err[int](io.EOF), nursery.Ok(3), nursery.Err[int](errUnexpected),
+	}
+
+	values, errs := nursery.SplitResults(results)
+	if !slices.Equal(values, []int{1, 3}) || len(errs) != 2 || errs[0] != io.EOF || errs[1] != errUnexpected {
+		t.Fatalf("expected the values and errors in order, got %v and %v", values, errs)
+	}
+
+	if err := nursery.JoinErrors(results); !errors.Is(err, io.EOF) || !errors.Is(err, errUnexpected) {
+		t.Fatalf("expected both errors to be joined, got %v", err)
+	}
+
+	if err := nursery.JoinErrors([]nursery.Result[int]{nursery.Ok(1)}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestResult_WrapsError(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[nursery.Result[int]](nursery.WithErrorWrapping())
+	unbounded.GoNamed("parse", func() nursery.Result[int] { return nursery.Err[int](io.EOF) })
+	unbounded.GoNamed("count", func() nursery.Result[int] { return nursery.Ok(1) })
+
+	values, errs := nursery.SplitResults(unbounded.Wait())
+	if !slices.Equal(values, []int{1}) || len(errs) != 1 || errs[0].Error() != `job "parse": EOF` {
+		t.Fatalf("expected the error to be wrapped and the value untouched, got %v and %v", values, errs)
+	}
+}
@@ -0,0 +1,169 @@
+package nursery
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RetryPolicy retries jobs, whose results report an error, see [WithRetry].
+// Results report errors, if they are an error, or a [Tuple] with an error as second component.
+type RetryPolicy struct {
+	// MaxAttempts limits how often a job is started, including the first attempt.
+	MaxAttempts int
+	// Backoff returns the delay before the next attempt, given the number of the failed attempt.
+	// If it is nil, jobs are retried right away.
+	Backoff func(attempt int) time.Duration
+	// Retryable reports whether the error is worth another attempt.
+	// If it is nil, all errors are retried, except for those marked via [Permanent].
+	Retryable func(err error) bool
+	// Budget caps the retries across all jobs sharing it, if it is set.
+	Budget *RetryBudget
+}
+
+// retries reports whether a job should be started again, after the given attempt failed with err.
+func (policy *RetryPolicy) retries(attempt int, err error) bool {
+	if attempt == 1 && policy.Budget != nil {
+		policy.Budget.Deposit()
+	}
+
+	if err == nil || attempt >= policy.MaxAttempts {
+		return false
+	}
+
+	if policy.Retryable == nil && IsPermanent(err) {
+		return false
+	}
+
+	if policy.Retryable != nil && !policy.Retryable(err) {
+		return false
+	}
+
+	return policy.Budget == nil || policy.Budget.Withdraw()
+}
+
+// RetryBudget is a token bucket shared by jobs, that caps their retries relative to their first attempts,
+// e.g. at 10% extra load, so a failing downstream does not cause a retry storm.
+// Every first attempt deposits ratio tokens, every retry withdraws one.
+// It is safe for concurrent use, and can also be used for hedged requests.
+type RetryBudget struct {
+	mx     sync.Mutex
+	ratio  float64
+	tokens float64
+	burst  float64
+}
+
+// NewRetryBudget returns a budget, that allows ratio retries per first attempt.
+// It starts with, and holds at most, burst tokens, so a few retries are possible right away.
+func NewRetryBudget(ratio float64, burst int) *RetryBudget {
+	if ratio < 0 {
+		panic(fmt.Sprintf("retry ratio must not be negative, but was %v", ratio))
+	}
+
+	if burst < 1 {
+		panic(fmt.Sprintf("retry burst must be at least 1, but was %d", burst))
+	}
+
+	return &RetryBudget{mx: sync.Mutex{}, ratio: ratio, tokens: float64(burst), burst: float64(burst)}
+}
+
+// Deposit adds the tokens earned by a first attempt.
+func (budget *RetryBudget) Deposit() {
+	budget.mx.Lock()
+	defer budget.mx.Unlock()
+
+	budget.tokens = min(budget.tokens+budget.ratio, budget.burst)
+}
+
+// Withdraw takes a token for a retry, and reports whether there was one.
+func (budget *RetryBudget) Withdraw() bool {
+	budget.mx.Lock()
+	defer budget.mx.Unlock()
+
+	if budget.tokens < 1 {
+		return false
+	}
+
+	budget.tokens--
+
+	return true
+}
+
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	if policy.Backoff == nil {
+		return 0
+	}
+
+	return policy.Backoff(attempt)
+}
+
+// permanentError marks an error as not retryable.
+type permanentError struct {
+	err error
+}
+
+func (err *permanentError) Error() string { return err.err.Error() }
+
+func (err *permanentError) Unwrap() error { return err.err }
+
+// Permanent marks the error as not retryable, so a [RetryPolicy] without classifier fails the job right away.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether the error, or one it wraps, was marked via [Permanent].
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+
+	return errors.As(err, &permanent)
+}
+
+// retryLoop runs the job again on the same goroutine, as long as the policy retries it.
+func retryLoop[R any](policy *RetryPolicy, job func() R) func() R {
+	return func() R {
+		for attempt := 1; ; attempt++ {
+			result := job()
+			if !policy.retries(attempt, errorOf(result)) {
+				return result
+			}
+
+			time.Sleep(policy.backoff(attempt))
+		}
+	}
+}
+
+// retryRequeue requeues the job, as long as the policy retries it, see [Attempt.Requeue].
+func retryRequeue[R any](policy *RetryPolicy, job func(attempt *Attempt) R) func(attempt *Attempt) R {
+	return func(attempt *Attempt) R {
+		result := job(attempt)
+		if !attempt.requeued && policy.retries(attempt.Number(), errorOf(result)) {
+			attempt.Requeue(policy.backoff(attempt.Number()))
+		}
+
+		return result
+	}
+}
+
+// errorOf returns the error reported by the result, see [mapError].
+func errorOf[R any](result R) error {
+	var reported error
+
+	mapError(result, func(err error) error {
+		reported = err
+
+		return err
+	})
+
+	return reported
+}
+
+func checkRetryPolicy(policy RetryPolicy) {
+	if policy.MaxAttempts < 1 {
+		panic(fmt.Sprintf("max attempts must be at least 1, but was %d", policy.MaxAttempts))
+	}
+}
@@ -0,0 +1,132 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+var errTransient = errors.New("transient")
+
+func TestWithRetry_RetriesUntilSuccess(t *testing.T) {
+	t.Parallel()
+
+	for name, newNursery := range map[string]func(...nursery.Option) nursery.Source[error]{
+		"unbounded": func(opts ...nursery.Option) nursery.Source[error] {
+			return goFlaky(nursery.NewUnbounded[error](opts...))
+		},
+		"bounded": func(opts ...nursery.Option) nursery.Source[error] {
+			return goFlaky(nursery.NewBounded[error](context.TODO(), 1, opts...))
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results := newNursery(nursery.WithRetry(nursery.RetryPolicy{
+				MaxAttempts: 3,
+				Backoff:     func(int) time.Duration { return time.Millisecond },
+				Retryable:   nil,
+				Budget:      nil,
+			})).Wait()
+
+			if len(results) != 1 || results[0] != nil {
+				t.Fatalf("expected the third attempt to succeed, got %v", results)
+			}
+		})
+	}
+}
+
+func TestWithRetry_GivesUp(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+
+	results := nursery.WithUnbounded(func(Go nursery.Go[error]) {
+		Go(func() error {
+			attempts.Add(1)
+
+			return errTransient
+		})
+	}, nursery.WithRetry(nursery.RetryPolicy{MaxAttempts: 2, Backoff: nil, Retryable: nil, Budget: nil}))
+
+	if attempts.Load() != 2 || !errors.Is(results[0], errTransient) {
+		t.Fatalf("expected 2 attempts and the last error, got %d and %v", attempts.Load(), results)
+	}
+}
+
+func TestWithRetry_PermanentErrorsFailImmediately(t *testing.T) {
+	t.Parallel()
+
+	for name, policy := range map[string]nursery.RetryPolicy{
+		"permanent": {MaxAttempts: 3, Backoff: nil, Retryable: nil, Budget: nil},
+		"classifier": {MaxAttempts: 3, Backoff: nil, Budget: nil, Retryable: func(err error) bool {
+			return errors.Is(err, errTransient)
+		}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+
+			nursery.WithBounded(context.TODO(), 1, func(Go nursery.Go[nursery.Tuple[int, error]]) {
+				Go(func() nursery.Tuple[int, error] {
+					attempts.Add(1)
+
+					return nursery.NewTuple(0, nursery.Permanent(io.EOF))
+				})
+			}, nursery.WithRetry(policy))
+
+			if attempts.Load() != 1 {
+				t.Fatalf("expected a single attempt, got %d", attempts.Load())
+			}
+		})
+	}
+}
+
+func TestWithRetry_BudgetCapsRetries(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+
+	budget := nursery.NewRetryBudget(0.1, 1)
+
+	nursery.WithBounded(context.TODO(), 4, func(Go nursery.Go[error]) {
+		for range 20 {
+			Go(func() error {
+				attempts.Add(1)
+
+				return errTransient
+			})
+		}
+	}, nursery.WithRetry(nursery.RetryPolicy{MaxAttempts: 10, Backoff: nil, Retryable: nil, Budget: budget}))
+
+	// 20 first attempts earn 2 retries, in addition to the initial token, which is available right away.
+	if retries := attempts.Load() - 20; retries < 1 || retries > 3 {
+		t.Fatalf("expected the budget to allow between 1 and 3 retries, got %d", retries)
+	}
+}
+
+type goer interface {
+	nursery.Source[error]
+	Go(job func() error)
+}
+
+// goFlaky starts a job, that fails twice before it succeeds.
+func goFlaky(target goer) goer {
+	var attempts atomic.Int32
+
+	target.Go(func() error {
+		if attempts.Add(1) < 3 {
+			return errTransient
+		}
+
+		return nil
+	})
+
+	return target
+}
@@ -0,0 +1,105 @@
+package nursery_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func route(result int) string {
+	if result%2 == 0 {
+		return "ok"
+	}
+
+	return "needs-review"
+}
+
+func TestWithRouter(t *testing.T) {
+	t.Parallel()
+
+	ok, review := nursery.Grouped(route), nursery.Grouped(route)
+
+	n := nursery.NewUnbounded[int](nursery.WithRouter(route, map[string]func(int){
+		"ok":           ok.Write,
+		"needs-review": review.Write,
+	}))
+
+	for i := range 6 {
+		n.Go(func() int { return i })
+	}
+
+	if results := n.Wait(); len(results) != 0 {
+		t.Fatalf("expected all results to be routed, got %v", results)
+	}
+
+	evens, odds := ok.Groups()["ok"], review.Groups()["needs-review"]
+	slices.Sort(evens)
+	slices.Sort(odds)
+
+	if !slices.Equal(evens, []int{0, 2, 4}) || !slices.Equal(odds, []int{1, 3, 5}) {
+		t.Fatalf("expected the results to be routed by their parity, got %v and %v", evens, odds)
+	}
+}
+
+func TestWithRouter_RetainsUnrouted(t *testing.T) {
+	t.Parallel()
+
+	var review []int
+
+	n := nursery.NewUnbounded[int](nursery.WithRouter(route, map[string]func(int){
+		"needs-review": func(result int) { review = append(review, result) },
+	}))
+
+	for i := range 4 {
+		n.Go(func() int { return i })
+	}
+
+	results := n.Wait()
+	slices.Sort(results)
+	slices.Sort(review)
+
+	if !slices.Equal(results, []int{0, 2}) || !slices.Equal(review, []int{1, 3}) {
+		t.Fatalf("expected unrouted results to be retained, got %v and %v", results, review)
+	}
+}
+
+func TestWithRouter_FallsBackToSink(t *testing.T) {
+	t.Parallel()
+
+	var routed, sunk int
+
+	n := nursery.NewUnbounded[int](
+		nursery.WithRouter(route, map[string]func(int){"ok": func(int) { routed++ }}),
+		nursery.WithSink(func(int) { sunk++ }),
+	)
+
+	for i := range 4 {
+		n.Go(func() int { return i })
+	}
+
+	if results := n.Wait(); len(results) != 0 || routed != 2 || sunk != 2 {
+		t.Fatalf("expected 2 routed and 2 sunk results, got %v, %d and %d", results, routed, sunk)
+	}
+}
+
+func TestWithRouter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	if got := recovered(func() { nursery.WithRouter[int](nil, nil) }); got != "route must not be nil" {
+		t.Fatalf("expected a panic for a missing route, got %q", got)
+	}
+
+	if got := recovered(func() {
+		nursery.WithRouter(route, map[string]func(int){"ok": nil})
+	}); got != `sink "ok" must not be nil` {
+		t.Fatalf("expected a panic for a missing sink, got %q", got)
+	}
+
+	got := recovered(func() {
+		nursery.NewUnbounded[string](nursery.WithRouter(route, nil))
+	})
+	if got == nil {
+		t.Fatal("expected a panic for a router of the wrong result type")
+	}
+}
@@ -0,0 +1,24 @@
+package nursery
+
+// GoRead is like [Bounded.Go], but runs the job as a reader:
+// readers run concurrently, but not while a writer runs, see [Bounded.GoWrite].
+// Like with a [sync.RWMutex], readers submitted after a writer wait for it,
+// so writers are not starved; other jobs are not affected by readers and writers.
+// Tags are ignored for readers and writers.
+func (nursery *Bounded[R]) GoRead(job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.submitAccess(accessRead, 0, job)
+}
+
+// GoWrite is like [Bounded.Go], but runs the job as a writer,
+// which runs once no reader or other writer runs, see [Bounded.GoRead].
+func (nursery *Bounded[R]) GoWrite(job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.submitAccess(accessWrite, 0, job)
+}
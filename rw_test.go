@@ -0,0 +1,82 @@
+package nursery_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBounded_ReadersAndWriters(t *testing.T) {
+	t.Parallel()
+
+	var readers, writers, violations, concurrentReads atomic.Int64
+
+	bounded := nursery.NewBounded[int](context.TODO(), 4)
+
+	for job := range 40 {
+		if job%8 == 7 {
+			bounded.GoWrite(func() int {
+				if writers.Add(1) != 1 || readers.Load() != 0 {
+					violations.Add(1)
+				}
+
+				time.Sleep(time.Millisecond)
+				writers.Add(-1)
+
+				return job
+			})
+
+			continue
+		}
+
+		bounded.GoRead(func() int {
+			if concurrent := readers.Add(1); concurrent > 1 {
+				concurrentReads.Add(1)
+			}
+
+			if writers.Load() != 0 {
+				violations.Add(1)
+			}
+
+			time.Sleep(time.Millisecond)
+			readers.Add(-1)
+
+			return job
+		})
+	}
+
+	if results := bounded.Wait(); len(results) != 40 || violations.Load() != 0 {
+		t.Fatalf("expected writers to run alone, got %d violations in %v", violations.Load(), results)
+	}
+
+	if concurrentReads.Load() == 0 {
+		t.Fatal("expected readers to run concurrently")
+	}
+}
+
+func TestBounded_WritersDoNotBlockOtherJobs(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[string](context.TODO(), 2)
+	release := make(chan struct{})
+
+	bounded.GoWrite(func() string {
+		<-release
+
+		return "writer"
+	})
+	bounded.GoRead(func() string { return "reader" })
+	bounded.Go(func() string {
+		close(release)
+
+		return "other"
+	})
+
+	results := bounded.Wait()
+	if len(results) != 3 || results[0] != "other" || results[1] != "writer" || results[2] != "reader" {
+		t.Fatalf("expected the other job to run besides the writer, got %v", results)
+	}
+}
@@ -0,0 +1,84 @@
+package nursery
+
+// Scope starts jobs in the nursery it was passed from, see [Unbounded.GoScoped].
+// The started jobs receive the scope themselves, which makes recursive fan-out,
+// e.g. crawling or walking trees, straightforward.
+//
+// A Scope may be called while [Unbounded.Wait] or [Bounded.Wait] is waiting,
+// but only by jobs of the nursery, that are still running.
+type Scope[R any] func(job func(Go Scope[R]) R)
+
+// GoScoped is like [Unbounded.Go], but passes a [Scope] to the job,
+// that can be used to start further jobs in the same nursery.
+func (nursery *Unbounded[R]) GoScoped(job func(Go Scope[R]) R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.init()
+	nursery.mx.RLock()
+	defer nursery.mx.RUnlock()
+
+	if nursery.closed {
+		panic(ErrClosed)
+	}
+
+	nursery.spawnAfter(0, JobInfo{Index: 0, Tag: "", Name: ""}, nursery.scoped(-1, job))
+}
+
+// scoped passes a scope to the job, that starts its children, and links the job to its parent, if it has one.
+func (nursery *Unbounded[R]) scoped(parent int, job func(Go Scope[R]) R) func(JobInfo) R {
+	return func(info JobInfo) R {
+		nursery.link(parent, info.Index)
+
+		return job(unboundedScope[R]{nursery: nursery, parent: info.Index}.goChild)
+	}
+}
+
+// unboundedScope starts the children of the job with the parent index, see [Scope].
+type unboundedScope[R any] struct {
+	nursery *Unbounded[R]
+	parent  int
+}
+
+func (scope unboundedScope[R]) goChild(job func(Go Scope[R]) R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	scope.nursery.spawnAfter(0, JobInfo{Index: 0, Tag: "", Name: ""}, scope.nursery.scoped(scope.parent, job))
+}
+
+// GoScoped is like [Bounded.Go], but passes a [Scope] to the job,
+// that can be used to start further jobs in the same nursery.
+// Jobs started via the scope are bound by the same limit.
+func (nursery *Bounded[R]) GoScoped(job func(Go Scope[R]) R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.submit(nursery.slots, JobInfo{Index: 0, Tag: "", Name: ""}, nursery.scoped(-1, job))
+}
+
+// scoped is like [Unbounded.scoped].
+func (nursery *Bounded[R]) scoped(parent int, job func(Go Scope[R]) R) func(*Attempt) R {
+	return func(attempt *Attempt) R {
+		nursery.inner.link(parent, attempt.job)
+
+		return job(boundedScope[R]{nursery: nursery, parent: attempt.job}.goChild)
+	}
+}
+
+// boundedScope starts the children of the job with the parent index, see [Scope].
+type boundedScope[R any] struct {
+	nursery *Bounded[R]
+	parent  int
+}
+
+func (scope boundedScope[R]) goChild(job func(Go Scope[R]) R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	scope.nursery.spawn(scope.nursery.slots, JobInfo{Index: 0, Tag: "", Name: ""}, scope.nursery.scoped(scope.parent, job))
+}
@@ -0,0 +1,53 @@
+package nursery_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+// walk returns a job, that visits the given node of a binary tree of the given depth.
+func walk(node, depth int) func(Go nursery.Scope[int]) int {
+	return func(Go nursery.Scope[int]) int {
+		// Make sure, that children are started while the nursery is waiting.
+		time.Sleep(time.Millisecond)
+
+		if depth > 0 {
+			Go(walk(2*node, depth-1))
+			Go(walk(2*node+1, depth-1))
+		}
+
+		return node
+	}
+}
+
+func TestUnbounded_GoScopedRecursive(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+	unbounded.GoScoped(walk(1, 5))
+
+	visited := unbounded.Wait()
+	slices.Sort(visited)
+
+	if len(visited) != 63 || visited[0] != 1 || visited[62] != 63 {
+		t.Fatalf("expected all 63 nodes to be visited, got %v", visited)
+	}
+}
+
+func TestBounded_GoScopedRecursive(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 2)
+	bounded.GoScoped(walk(1, 5))
+
+	visited := bounded.Wait()
+	slices.Sort(visited)
+
+	if len(visited) != 63 || visited[0] != 1 || visited[62] != 63 {
+		t.Fatalf("expected all 63 nodes to be visited, got %v", visited)
+	}
+}
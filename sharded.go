@@ -0,0 +1,138 @@
+package nursery
+
+import (
+	"fmt"
+	"hash/maphash"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// Sharded spreads jobs across several [Unbounded] nurseries,
+// so that many goroutines submitting concurrently do not contend for a single nursery.
+// Jobs started via [Sharded.GoKeyed] are routed by a hash of their key, so jobs with the same key share a shard,
+// while jobs started via [Sharded.Go] have no key and are spread round-robin, which balances them best.
+type Sharded[R any] struct {
+	shards   []*Unbounded[R]
+	next     atomic.Uint64
+	seed     maphash.Seed
+	waited   sync.Once
+	finalize func([]R) []R
+	// tracer is shared by all shards, if a trace is written, see [WithTrace].
+	tracer      *tracer
+	traceWriter io.Writer
+	results     []R
+	completion  *completion
+}
+
+var (
+	_ Waiter      = (*Sharded[any])(nil)
+	_ Source[any] = (*Sharded[any])(nil)
+)
+
+// NewSharded returns a new nursery, that distributes its jobs across the given number of shards.
+// The options apply to every shard, except for [WithFinalizer] and [WithDedup],
+// which apply to the merged results, and [WithTrace], which writes a single trace of all shards.
+// [WithGraph] is ignored.
+func NewSharded[R any](shards int, opts ...Option) *Sharded[R] {
+	if shards < 1 {
+		panic(fmt.Sprintf("shards must be at least 1, but was %d", shards))
+	}
+
+	cfg := newConfig(opts)
+
+	nursery := &Sharded[R]{
+		shards:      make([]*Unbounded[R], shards),
+		next:        atomic.Uint64{},
+		seed:        maphash.MakeSeed(),
+		waited:      sync.Once{},
+		finalize:    finalizer[R](cfg),
+		tracer:      nil,
+		traceWriter: cfg.trace,
+		results:     nil,
+		completion:  newCompletion(),
+	}
+
+	if cfg.trace != nil {
+		nursery.tracer = newTracer()
+	}
+
+	cfg.finalize, cfg.dedup, cfg.trace, cfg.graph = nil, nil, nil, nil
+
+	for i := range nursery.shards {
+		nursery.shards[i] = newUnbounded[R](cfg)
+		nursery.shards[i].tracer = nursery.tracer
+	}
+
+	publish(cfg.expvar, nursery.Stats)
+
+	return nursery
+}
+
+// Go runs the code given via the closure in the background and collects its result.
+// Consecutive jobs are started in different shards.
+func (nursery *Sharded[R]) Go(job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	shard := nursery.next.Add(1) % uint64(len(nursery.shards))
+
+	nursery.shards[shard].Go(job)
+}
+
+// GoKeyed is like [Sharded.Go], but starts the job in the shard given by a hash of the key,
+// so jobs with the same key always land in the same shard.
+func (nursery *Sharded[R]) GoKeyed(key string, job func() R) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	shard := maphash.String(nursery.seed, key) % uint64(len(nursery.shards))
+
+	nursery.shards[shard].Go(job)
+}
+
+// Wait blocks and returns the results of all shards, once all jobs are finished.
+// Subsequent calls return the same results.
+// Once Wait is called, starting new jobs panics.
+// If a job panicked, Wait rethrows the first panic of the first shard like [Unbounded.Wait].
+func (nursery *Sharded[R]) Wait() []R {
+	results := nursery.wait()
+
+	for _, shard := range nursery.shards {
+		shard.rethrow()
+	}
+
+	return results
+}
+
+// wait is like Wait, but does not rethrow panics.
+func (nursery *Sharded[R]) wait() []R {
+	nursery.waited.Do(func() {
+		// All shards reject new jobs, before any of them is drained.
+		for _, shard := range nursery.shards {
+			shard.close()
+		}
+
+		for _, shard := range nursery.shards {
+			nursery.results = append(nursery.results, shard.wait()...)
+		}
+
+		if nursery.finalize != nil {
+			nursery.results = nursery.finalize(nursery.results)
+		}
+
+		if nursery.traceWriter != nil {
+			// Failing to write the trace must not fail the nursery.
+			_ = nursery.tracer.write(nursery.traceWriter)
+		}
+	})
+
+	return nursery.results
+}
+
+// Join implements [Waiter].
+func (nursery *Sharded[R]) Join() {
+	nursery.Wait()
+}
@@ -0,0 +1,139 @@
+package nursery_test
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestSharded_ConcurrentProducers(t *testing.T) {
+	t.Parallel()
+
+	const producers, jobs = 16, 100
+
+	sharded := nursery.NewSharded[int](4)
+
+	var submitting sync.WaitGroup
+
+	for producer := range producers {
+		submitting.Add(1)
+
+		go func() {
+			defer submitting.Done()
+
+			for job := range jobs {
+				sharded.Go(func() int {
+					return producer*jobs + job
+				})
+			}
+		}()
+	}
+
+	submitting.Wait()
+
+	results := sharded.Wait()
+	slices.Sort(results)
+
+	for i, result := range results {
+		if i != result {
+			t.Fatalf("expected all %d jobs to complete, got %v", producers*jobs, results)
+		}
+	}
+
+	if len(results) != producers*jobs {
+		t.Fatalf("expected %d results, got %d", producers*jobs, len(results))
+	}
+}
+
+func TestSharded_GoKeyed(t *testing.T) {
+	t.Parallel()
+
+	sharded := nursery.NewSharded[int](4)
+	release := make(chan struct{})
+
+	for job := range 10 {
+		sharded.GoKeyed("tenant", func() int {
+			<-release
+
+			return job
+		})
+	}
+
+	for sharded.Stats().Running < 10 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The peak is the one of the busiest shard, so it only covers all jobs, if they share a shard.
+	if peak := sharded.Stats().Peak; peak != 10 {
+		t.Fatalf("expected all jobs of the key to run in the same shard, got a peak of %d", peak)
+	}
+
+	close(release)
+
+	if results := sharded.Wait(); len(results) != 10 {
+		t.Fatalf("expected all jobs to complete, got %v", results)
+	}
+}
+
+func TestSharded_WaitClosesAllShards(t *testing.T) {
+	t.Parallel()
+
+	sharded := nursery.NewSharded[int](2)
+	release := make(chan struct{})
+
+	// Consecutive jobs start in different shards, the second one blocks the drain of its shard.
+	sharded.Go(func() int { return 1 })
+	sharded.Go(func() int {
+		<-release
+
+		return 2
+	})
+
+	waited := make(chan []int)
+
+	go func() { waited <- sharded.Wait() }()
+
+	for sharded.TryGo(func() int { return 0 }) == nil {
+		time.Sleep(time.Millisecond)
+	}
+
+	for range 2 {
+		if err := sharded.TryGo(func() int { return 0 }); !errors.Is(err, nursery.ErrClosed) {
+			t.Fatalf("expected every shard to reject jobs, while the first one is drained, got %v", err)
+		}
+	}
+
+	close(release)
+
+	if results := <-waited; !slices.Contains(results, 1) || !slices.Contains(results, 2) {
+		t.Fatalf("expected the results of both shards, got %v", results)
+	}
+}
+
+func BenchmarkUnbounded_ParallelGo(b *testing.B) {
+	unbounded := nursery.NewUnbounded[int]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			unbounded.Go(func() int { return 0 })
+		}
+	})
+
+	unbounded.Wait()
+}
+
+func BenchmarkSharded_ParallelGo(b *testing.B) {
+	sharded := nursery.NewSharded[int](16)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			sharded.Go(func() int { return 0 })
+		}
+	})
+
+	sharded.Wait()
+}
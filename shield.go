@@ -0,0 +1,31 @@
+package nursery
+
+import (
+	"context"
+	"time"
+)
+
+// Shield runs the critical section, e.g. a commit or a cleanup, with a context,
+// that is not cancelled right away, once ctx is, so the section is not torn in half by the cancellation of a scope.
+// Instead, the context of the section is cancelled with the cause of ctx,
+// once ctx has been done for the grace period, which bounds how long a cancellation is delayed.
+// The context keeps the values of ctx, and Shield returns the error of the section.
+func Shield(ctx context.Context, grace time.Duration, section func(ctx context.Context) error) error {
+	shielded, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+
+	stop := context.AfterFunc(ctx, func() {
+		timer := time.NewTimer(grace)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			cancel(context.Cause(ctx))
+		case <-shielded.Done():
+		}
+	})
+
+	defer stop()
+	defer cancel(nil)
+
+	return section(shielded)
+}
@@ -0,0 +1,59 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestShield_DelaysCancellation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := nursery.Shield(ctx, time.Hour, func(ctx context.Context) error {
+		cancel()
+		time.Sleep(time.Millisecond)
+
+		return ctx.Err()
+	})
+	if err != nil {
+		t.Fatalf("expected the section to finish despite the cancellation, got %v", err)
+	}
+}
+
+func TestShield_CancelsAfterGrace(t *testing.T) {
+	t.Parallel()
+
+	errAborted := errors.New("scope aborted")
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	err := nursery.Shield(ctx, time.Millisecond, func(ctx context.Context) error {
+		cancel(errAborted)
+		<-ctx.Done()
+
+		return context.Cause(ctx)
+	})
+	if !errors.Is(err, errAborted) {
+		t.Fatalf("expected the section to be cancelled with the cause of the scope, got %v", err)
+	}
+}
+
+func TestShield_KeepsValues(t *testing.T) {
+	t.Parallel()
+
+	type key struct{}
+
+	ctx := context.WithValue(context.Background(), key{}, "value")
+
+	_ = nursery.Shield(ctx, time.Second, func(ctx context.Context) error {
+		if ctx.Value(key{}) != "value" {
+			t.Fatal("expected the shielded context to keep the values")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,40 @@
+package nursery_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestNewBoundedSimple(t *testing.T) {
+	t.Parallel()
+
+	var running, peak atomic.Int64
+
+	n := nursery.NewBoundedSimple[int](2)
+
+	for job := range 10 {
+		n.Go(func() int {
+			current := running.Add(1)
+			defer running.Add(-1)
+
+			for previous := peak.Load(); current > previous && !peak.CompareAndSwap(previous, current); {
+				previous = peak.Load()
+			}
+
+			time.Sleep(time.Millisecond)
+
+			return job
+		})
+	}
+
+	if results := n.Wait(); len(results) != 10 || peak.Load() > 2 {
+		t.Fatalf("expected 10 results with at most 2 jobs in parallel, got %d and %d", len(results), peak.Load())
+	}
+
+	if n.Context().Err() == nil {
+		t.Fatal("expected the context of the jobs to be cancelled once Wait returned")
+	}
+}
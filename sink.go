@@ -0,0 +1,40 @@
+package nursery
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONLinesSink writes results as JSON lines, see [JSONLines].
+// Writes are serialized, so it is safe for concurrent use.
+type JSONLinesSink[R any] struct {
+	mx      sync.Mutex
+	encoder *json.Encoder
+	err     error
+}
+
+// JSONLines returns a sink, that encodes every result as a line of JSON and writes it to the writer,
+// to be passed to [WithSink] via its Write method.
+func JSONLines[R any](writer io.Writer) *JSONLinesSink[R] {
+	return &JSONLinesSink[R]{mx: sync.Mutex{}, encoder: json.NewEncoder(writer), err: nil}
+}
+
+// Write writes the result, unless a previous write failed.
+func (sink *JSONLinesSink[R]) Write(result R) {
+	sink.mx.Lock()
+	defer sink.mx.Unlock()
+
+	if sink.err == nil {
+		sink.err = sink.encoder.Encode(result)
+	}
+}
+
+// Err returns the error of the first write, that failed.
+// Results are dropped after it.
+func (sink *JSONLinesSink[R]) Err() error {
+	sink.mx.Lock()
+	defer sink.mx.Unlock()
+
+	return sink.err
+}
@@ -0,0 +1,70 @@
+package nursery_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithSink_JSONLines(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	sink := nursery.JSONLines[int](&out)
+
+	results := nursery.WithBounded(context.TODO(), 2, func(Go nursery.Go[int]) {
+		for job := range 5 {
+			Go(func() int { return job })
+		}
+	}, nursery.WithSink(sink.Write))
+
+	if len(results) != 0 || sink.Err() != nil {
+		t.Fatalf("expected results to be streamed only, got %v and %v", results, sink.Err())
+	}
+
+	var streamed []int
+
+	decoder := json.NewDecoder(&out)
+	for decoder.More() {
+		var result int
+		if err := decoder.Decode(&result); err != nil {
+			t.Fatal(err)
+		}
+
+		streamed = append(streamed, result)
+	}
+
+	slices.Sort(streamed)
+
+	if !slices.Equal(streamed, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("expected all results to be streamed, got %v", streamed)
+	}
+}
+
+func TestJSONLines_KeepsFirstError(t *testing.T) {
+	t.Parallel()
+
+	errBroken := errors.New("broken")
+	sink := nursery.JSONLines[int](failingWriter{err: errBroken})
+
+	sink.Write(1)
+	sink.Write(2)
+
+	if !errors.Is(sink.Err(), errBroken) {
+		t.Fatalf("expected the write error, got %v", sink.Err())
+	}
+}
+
+type failingWriter struct {
+	err error
+}
+
+func (writer failingWriter) Write([]byte) (int, error) {
+	return 0, writer.err
+}
@@ -0,0 +1,77 @@
+package nursery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBounded_Skipped(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	n := nursery.NewBounded[int](ctx, 1)
+	n.Go(func() int {
+		<-n.Context().Done()
+
+		return 0
+	})
+
+	for n.Stats().Running < 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	for job := range 4 {
+		n.Go(func() int { return job })
+	}
+
+	cancel()
+
+	if results := n.Wait(); len(results) != 1 {
+		t.Fatalf("expected only the running job to finish, got %v", results)
+	}
+
+	if stats := n.Stats(); n.Skipped() != 4 || stats.Skipped != 4 || stats.Completed != 1 {
+		t.Fatalf("expected 4 skipped and 1 completed job, got %d and %+v", n.Skipped(), stats)
+	}
+}
+
+func TestBounded_SkippedNone(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewBoundedSimple[int](2)
+
+	for job := range 4 {
+		n.Go(func() int { return job })
+	}
+
+	n.Wait()
+
+	if n.Skipped() != 0 {
+		t.Fatalf("expected no skipped jobs, got %d", n.Skipped())
+	}
+}
+
+func TestBounded_SkippedDropped(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+
+	n := nursery.NewBoundedSimple[int](1, nursery.WithQueueLimit(0, nursery.QueueDrop))
+	n.Go(func() int {
+		<-release
+
+		return 0
+	})
+
+	n.Go(func() int { return 1 })
+	close(release)
+	n.Wait()
+
+	if n.Skipped() != 1 {
+		t.Fatalf("expected the dropped job to be skipped, got %d", n.Skipped())
+	}
+}
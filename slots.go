@@ -0,0 +1,511 @@
+package nursery
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// slots hands out a limited number of slots to waiting jobs.
+//
+// Waiting jobs are ordered via self-clocked fair queuing:
+// every waiter gets a virtual finish tag, that grows by the inverse of its
+// tag's weight, and the waiter with the lowest finish tag gets the next slot.
+// Without weights all jobs share a single class, which degrades to FIFO.
+//
+// Every slot is a lane, that tracks how long it was busy.
+// Once a job in a lane finishes, the lane is handed over to the next waiter right away,
+// so a worker keeps running jobs, as long as any are waiting.
+//
+// Exclusive waiters take precedence over all others: once one is waiting, no other waiter gets a slot,
+// and it gets one as soon as all slots are free.
+// Readers and writers share a class of their own, in which a writer waits until no reader runs,
+// and readers wait while a writer runs or waits, like with a [sync.RWMutex].
+type slots struct {
+	mx      sync.Mutex
+	limit   int
+	used    int
+	weights map[string]int
+	classes map[string]*class
+	// virtual is the finish tag of the waiter that got a slot last.
+	virtual float64
+	lanes   []*lane
+	// exclusive holds the exclusive waiters, isolated is set while one of them runs.
+	exclusive list.List
+	isolated  bool
+	// rw holds the waiting readers and writers, readers counts the running readers.
+	rw      class
+	readers int
+	writing bool
+	// free lists the lanes, that are currently not used.
+	free []int
+	// start runs the given function in the background, e.g. on a worker of a pool.
+	start func(func())
+	// handovers counts the waiters, that were run by the worker of a previous one.
+	handovers int
+	closed    bool
+	// decisions is only set, if scheduling decisions are logged, bulkhead names the slots of a bulkhead.
+	decisions *ring[Decision]
+	bulkhead  string
+}
+
+type lane struct {
+	since     time.Time
+	busySince time.Time
+	busy      time.Duration
+	jobs      int
+}
+
+type class struct {
+	weight  int
+	waiters list.List
+	// finish is the finish tag of the waiter enqueued last.
+	finish float64
+}
+
+type waiter struct {
+	finish float64
+	run    func(lane int)
+	skip   func()
+	access access
+	job    JobInfo
+	// started is set, once the waiter got a slot.
+	started bool
+}
+
+// access is the kind of access to the slots, a waiter requires.
+type access int
+
+const (
+	// accessShared waiters only need a slot.
+	accessShared access = iota
+	// accessExclusive waiters need all slots.
+	accessExclusive
+	// accessRead waiters need a slot, while no writer runs or waits.
+	accessRead
+	// accessWrite waiters need a slot, while no reader or other writer runs.
+	accessWrite
+)
+
+// newSlots returns slots limited to the given number, that start waiters via start.
+// If weights is nil, all tags are treated the same.
+func newSlots(limit int, weights map[string]int, start func(func())) *slots {
+	return &slots{
+		mx:        sync.Mutex{},
+		limit:     limit,
+		used:      0,
+		weights:   weights,
+		classes:   map[string]*class{},
+		virtual:   0,
+		lanes:     nil,
+		exclusive: list.List{},
+		isolated:  false,
+		rw:        class{weight: 1, waiters: list.List{}, finish: 0},
+		readers:   0,
+		writing:   false,
+		free:      nil,
+		start:     start,
+		handovers: 0,
+		closed:    false,
+		decisions: nil,
+		bulkhead:  "",
+	}
+}
+
+// enqueue queues the job, that requires the given access, in the class of its tag,
+// run is started with the lane of the slot it got.
+// Tags are ignored for exclusive jobs, as well as for readers and writers.
+// If the slots are closed, skip is called instead.
+func (s *slots) enqueue(job JobInfo, access access, run func(lane int), skip func()) {
+	s.mx.Lock()
+
+	if s.closed {
+		s.mx.Unlock()
+		skip()
+
+		return
+	}
+
+	waiter := &waiter{finish: 0, run: run, skip: skip, access: access, job: job, started: false}
+
+	switch access {
+	case accessShared:
+		s.push(s.classOf(job.Tag), waiter)
+	case accessExclusive:
+		s.exclusive.PushBack(waiter)
+	case accessRead, accessWrite:
+		s.push(&s.rw, waiter)
+	}
+
+	s.dispatch()
+
+	if s.decisions != nil && !waiter.started {
+		s.decide(DecisionWait, job, -1, s.blocker(waiter))
+	}
+
+	s.mx.Unlock()
+}
+
+// claim gives the job a free slot right away, if no other waiter is queued, and runs it on the calling goroutine.
+// Once run returns, the lane is handed over to the next waiter.
+// It reports whether the job got a slot.
+func (s *slots) claim(job JobInfo, run func(lane int)) bool {
+	s.mx.Lock()
+
+	if s.closed || s.used >= s.limit || s.isolated || s.queued() {
+		s.mx.Unlock()
+
+		return false
+	}
+
+	waiter := &waiter{finish: 0, run: run, skip: nil, access: accessShared, job: job, started: true}
+	lane := s.take()
+	s.decide(DecisionSlot, job, lane, "slot was free, run by the submitter")
+
+	s.mx.Unlock()
+
+	defer func() {
+		if next, lane := s.handover(waiter, lane); next != nil {
+			s.start(func() {
+				s.serve(next, lane)
+			})
+		}
+	}()
+
+	waiter.run(lane)
+
+	return true
+}
+
+// queued reports whether any waiter is queued.
+func (s *slots) queued() bool {
+	for _, class := range s.classes {
+		if class.waiters.Len() > 0 {
+			return true
+		}
+	}
+
+	return s.exclusive.Len() > 0 || s.rw.waiters.Len() > 0
+}
+
+// close drops all waiters, calling their skip functions, and skips all future ones.
+func (s *slots) close() {
+	s.mx.Lock()
+
+	s.closed = true
+
+	var skipped []*waiter
+
+	for _, waiters := range s.queues() {
+		for elem := waiters.Front(); elem != nil; elem = elem.Next() {
+			waiter, _ := elem.Value.(*waiter)
+			skipped = append(skipped, waiter)
+		}
+
+		waiters.Init()
+	}
+
+	s.mx.Unlock()
+
+	for _, waiter := range skipped {
+		waiter.skip()
+	}
+}
+
+// resize changes the number of slots.
+func (s *slots) resize(limit int) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if limit != s.limit {
+		previous := s.limit
+		s.limit = limit
+		s.decide(DecisionLimit, JobInfo{Index: 0, Tag: "", Name: ""}, -1, fmt.Sprintf("limit changed from %d", previous))
+	}
+
+	s.dispatch()
+}
+
+// classOf returns the class of the tag, which is added on first use.
+func (s *slots) classOf(tag string) *class {
+	if s.weights == nil {
+		tag = ""
+	}
+
+	queue, ok := s.classes[tag]
+	if !ok {
+		weight, ok := s.weights[tag]
+		if !ok {
+			weight = 1
+		}
+
+		queue = &class{weight: weight, waiters: list.List{}, finish: 0}
+		s.classes[tag] = queue
+	}
+
+	return queue
+}
+
+// push queues the waiter in the class, with the next finish tag of the class.
+func (s *slots) push(queue *class, waiter *waiter) {
+	// A class that was idle must not gain credit for the time it did not use.
+	queue.finish = max(queue.finish, s.virtual) + 1/float64(queue.weight)
+	waiter.finish = queue.finish
+
+	queue.waiters.PushBack(waiter)
+}
+
+// queues returns the waiters of all classes, to drop them.
+func (s *slots) queues() []*list.List {
+	queues := make([]*list.List, 0, len(s.classes)+2)
+
+	for _, class := range s.classes {
+		queues = append(queues, &class.waiters)
+	}
+
+	return append(queues, &s.exclusive, &s.rw.waiters)
+}
+
+// pop removes the waiter with the lowest finish tag, that may run, or returns nil if there is none.
+func (s *slots) pop() *waiter {
+	var next *class
+
+	for _, class := range s.classes {
+		if class.waiters.Len() == 0 {
+			continue
+		}
+
+		if next == nil || head(class).finish < head(next).finish {
+			next = class
+		}
+	}
+
+	// Readers and writers wait in order, so a waiting writer holds back the readers behind it.
+	if s.rw.waiters.Len() > 0 && s.admits(head(&s.rw)) && (next == nil || head(&s.rw).finish < head(next).finish) {
+		next = &s.rw
+	}
+
+	if next == nil {
+		return nil
+	}
+
+	waiter, _ := next.waiters.Remove(next.waiters.Front()).(*waiter)
+	s.virtual = waiter.finish
+
+	return waiter
+}
+
+// admits reports whether the reader or writer may run.
+func (s *slots) admits(waiter *waiter) bool {
+	if waiter.access == accessRead {
+		return !s.writing
+	}
+
+	return !s.writing && s.readers == 0
+}
+
+// next removes the waiter, that gets the next free slot, or returns nil if there is none or no slot is free.
+func (s *slots) next() *waiter {
+	if s.used >= s.limit || s.isolated {
+		return nil
+	}
+
+	if front := s.exclusive.Front(); front != nil {
+		if s.used > 0 {
+			return nil
+		}
+
+		waiter, _ := s.exclusive.Remove(front).(*waiter)
+		s.acquire(waiter)
+
+		return waiter
+	}
+
+	waiter := s.pop()
+	if waiter != nil {
+		s.acquire(waiter)
+	}
+
+	return waiter
+}
+
+// acquire marks the access of the waiter, that got a slot, as held.
+func (s *slots) acquire(waiter *waiter) {
+	switch waiter.access {
+	case accessShared:
+	case accessExclusive:
+		s.isolated = true
+	case accessRead:
+		s.readers++
+	case accessWrite:
+		s.writing = true
+	}
+}
+
+// release marks the access of the waiter, that finished, as free again.
+func (s *slots) release(waiter *waiter) {
+	switch waiter.access {
+	case accessShared:
+	case accessExclusive:
+		s.isolated = false
+	case accessRead:
+		s.readers--
+	case accessWrite:
+		s.writing = false
+	}
+}
+
+// dispatch starts the next waiters in free slots.
+func (s *slots) dispatch() {
+	for {
+		waiter := s.next()
+		if waiter == nil {
+			return
+		}
+
+		lane := s.take()
+
+		waiter.started = true
+		s.decide(DecisionSlot, waiter.job, lane, "slot was free")
+
+		s.start(func() {
+			s.serve(waiter, lane)
+		})
+	}
+}
+
+// serve runs the waiter in the lane, and keeps running waiters in it, until none is left.
+// If a waiter calls runtime.Goexit, its lane is handed over to a new goroutine.
+func (s *slots) serve(waiter *waiter, lane int) {
+	returned := false
+
+	defer func() {
+		if returned {
+			return
+		}
+
+		if next, lane := s.handover(waiter, lane); next != nil {
+			s.start(func() {
+				s.serve(next, lane)
+			})
+		}
+	}()
+
+	for waiter != nil {
+		waiter.run(lane)
+
+		waiter, lane = s.handover(waiter, lane)
+	}
+
+	returned = true
+}
+
+// handover frees the lane of the finished waiter and returns the next waiter, together with the lane it got.
+func (s *slots) handover(finished *waiter, lane int) (*waiter, int) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.put(lane)
+	s.release(finished)
+
+	waiter := s.next()
+	if waiter == nil {
+		return nil, 0
+	}
+
+	s.handovers++
+
+	// As free lanes are a stack, this is the lane that was just freed.
+	lane = s.take()
+
+	waiter.started = true
+
+	if s.decisions != nil {
+		s.decide(DecisionSlot, waiter.job, lane, "handed over by "+finished.job.String())
+	}
+
+	// Other waiters may have been held back by the finished one, while slots were free.
+	if finished.access != accessShared {
+		s.dispatch()
+	}
+
+	return waiter, lane
+}
+
+// take marks a free lane as busy, or adds a new one.
+func (s *slots) take() int {
+	now := time.Now()
+
+	if len(s.free) == 0 {
+		s.lanes = append(s.lanes, &lane{since: now, busySince: now, busy: 0, jobs: 0})
+		s.free = append(s.free, len(s.lanes)-1)
+	}
+
+	id := s.free[len(s.free)-1]
+	s.free = s.free[:len(s.free)-1]
+
+	lane := s.lanes[id]
+	lane.busySince = now
+	lane.jobs++
+
+	s.used++
+
+	return id
+}
+
+// put marks the lane as free.
+func (s *slots) put(id int) {
+	lane := s.lanes[id]
+	lane.busy += time.Since(lane.busySince)
+	lane.busySince = time.Time{}
+
+	s.free = append(s.free, id)
+	s.used--
+}
+
+// workers returns the utilization of all lanes, that were used so far.
+func (s *slots) workers() []WorkerStats {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	now := time.Now()
+	workers := make([]WorkerStats, len(s.lanes))
+
+	for i, lane := range s.lanes {
+		busy := lane.busy
+		if !lane.busySince.IsZero() {
+			busy += now.Sub(lane.busySince)
+		}
+
+		workers[i] = WorkerStats{
+			Jobs: lane.jobs,
+			Busy: busy,
+			Idle: now.Sub(lane.since) - busy,
+		}
+	}
+
+	return workers
+}
+
+// size returns the current number of slots.
+func (s *slots) size() int {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.limit
+}
+
+// reused returns the number of waiters, that were run by the worker of a previous one.
+func (s *slots) reused() int {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return s.handovers
+}
+
+func head(class *class) *waiter {
+	waiter, _ := class.waiters.Front().Value.(*waiter)
+
+	return waiter
+}
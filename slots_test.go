@@ -0,0 +1,266 @@
+package nursery
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSlots_FIFOWithoutWeights(t *testing.T) {
+	t.Parallel()
+
+	starter := &starter{started: nil}
+	slots := newSlots(1, nil, starter.start)
+	order := queueTagged(slots, "b", "a", "b", "a")
+
+	starter.run()
+
+	if !slices.Equal(*order, []string{"b", "a", "b", "a"}) {
+		t.Fatalf("expected submission order, got %v", *order)
+	}
+}
+
+func TestSlots_WeightedFairQueuing(t *testing.T) {
+	t.Parallel()
+
+	starter := &starter{started: nil}
+	slots := newSlots(1, map[string]int{"a": 3}, starter.start)
+	order := queueTagged(slots, "b", "b", "b", "b", "a", "a", "a", "a", "a", "a")
+
+	starter.run()
+
+	got := *order
+
+	// "a" has finish tags 1/3, 2/3, 1, 4/3, ...; "b" has 1, 2, ...
+	if a := countTag(got[:4], "a"); a != 3 {
+		t.Fatalf("expected 3 of the first 4 slots to go to a, got %v", got)
+	}
+
+	if a := countTag(got[:8], "a"); a != 6 {
+		t.Fatalf("expected 6 of the first 8 slots to go to a, got %v", got)
+	}
+}
+
+func TestSlots_IdleClassGainsNoCredit(t *testing.T) {
+	t.Parallel()
+
+	starter := &starter{started: nil}
+	slots := newSlots(1, map[string]int{}, starter.start)
+
+	queueTagged(slots, "a", "a", "a", "a")
+	starter.run()
+
+	// "b" arrives late and must not get all slots, until it caught up with "a".
+	order := queueTagged(slots, "a", "a", "b", "b", "b", "b")
+	starter.run()
+
+	if b := countTag((*order)[:4], "b"); b != 2 {
+		t.Fatalf("expected late class to share slots, got %v", *order)
+	}
+}
+
+func TestSlots_CloseSkipsWaiters(t *testing.T) {
+	t.Parallel()
+
+	starter := &starter{started: nil}
+	slots := newSlots(1, nil, starter.start)
+
+	ran, skipped := 0, 0
+	run := func(int) { ran++ }
+	skip := func() { skipped++ }
+
+	slots.enqueue(tagged(""), accessShared, run, skip)
+	slots.enqueue(tagged(""), accessShared, run, skip)
+	slots.enqueue(tagged(""), accessShared, run, skip)
+
+	slots.close()
+	slots.enqueue(tagged(""), accessShared, run, skip)
+
+	starter.run()
+
+	if ran != 1 || skipped != 3 {
+		t.Fatalf("expected only the started job to run, got %d run and %d skipped", ran, skipped)
+	}
+
+	if slots.used != 0 {
+		t.Fatalf("expected no slots to be used, got %d", slots.used)
+	}
+}
+
+func TestSlots_Resize(t *testing.T) {
+	t.Parallel()
+
+	starter := &starter{started: nil}
+	slots := newSlots(1, nil, starter.start)
+	queueTagged(slots, "", "")
+
+	slots.resize(3)
+
+	if len(starter.started) != 3 {
+		t.Fatalf("expected all waiters to be started, got %d", len(starter.started))
+	}
+}
+
+func TestSlots_ExclusiveRunsAlone(t *testing.T) {
+	t.Parallel()
+
+	starter := &starter{started: nil}
+	slots := newSlots(2, nil, starter.start)
+
+	var order []string
+
+	used := 0
+	run := func(name string) func(int) {
+		return func(int) { order = append(order, name) }
+	}
+
+	slots.enqueue(tagged(""), accessShared, run("before"), func() {})
+	slots.enqueue(tagged(""), accessExclusive, func(int) {
+		used = slots.used
+		order = append(order, "exclusive")
+	}, func() {})
+	slots.enqueue(tagged(""), accessShared, run("after"), func() {})
+	slots.enqueue(tagged(""), accessShared, run("after"), func() {})
+
+	if len(starter.started) != 1 {
+		t.Fatalf("expected only the job before the exclusive one to start, got %d", len(starter.started))
+	}
+
+	starter.run()
+
+	if !slices.Equal(order, []string{"before", "exclusive", "after", "after"}) || used != 1 {
+		t.Fatalf("expected the exclusive job to run alone in between, got %v with %d used slots", order, used)
+	}
+
+	if len(slots.lanes) != 2 {
+		t.Fatalf("expected the later jobs to use both slots again, got %d lanes", len(slots.lanes))
+	}
+}
+
+func TestSlots_WriterWaitsForReaders(t *testing.T) {
+	t.Parallel()
+
+	starter := &starter{started: nil}
+	slots := newSlots(3, nil, starter.start)
+
+	var order []string
+
+	run := func(name string) func(int) {
+		return func(int) { order = append(order, name) }
+	}
+
+	slots.enqueue(tagged(""), accessRead, run("read"), func() {})
+	slots.enqueue(tagged(""), accessWrite, run("write"), func() {})
+	slots.enqueue(tagged(""), accessRead, run("read"), func() {})
+	slots.enqueue(tagged(""), accessShared, run("shared"), func() {})
+
+	// The second reader waits behind the writer, the shared job does not.
+	if len(starter.started) != 2 || slots.readers != 1 {
+		t.Fatalf("expected the first reader and the shared job to start, got %d", len(starter.started))
+	}
+
+	starter.run()
+
+	if !slices.Equal(order, []string{"read", "write", "read", "shared"}) {
+		t.Fatalf("expected the writer to run between the readers, got %v", order)
+	}
+
+	if slots.readers != 0 || slots.writing || slots.used != 0 {
+		t.Fatalf("expected all access to be released, got %d readers and writing %t", slots.readers, slots.writing)
+	}
+}
+
+func TestSlots_Workers(t *testing.T) {
+	t.Parallel()
+
+	var running sync.WaitGroup
+
+	slots := newSlots(2, nil, func(serve func()) {
+		running.Add(1)
+
+		go func() {
+			defer running.Done()
+
+			serve()
+		}()
+	})
+
+	release := make(chan struct{})
+
+	slots.enqueue(tagged(""), accessShared, func(int) {}, func() {})
+	slots.enqueue(tagged(""), accessShared, func(int) { <-release }, func() {})
+
+	time.Sleep(2 * time.Millisecond)
+
+	workers := slots.workers()
+	if len(workers) != 2 {
+		t.Fatalf("expected two workers, got %v", workers)
+	}
+
+	idle, busy := workers[0], workers[1]
+	if busy.Busy < idle.Busy {
+		idle, busy = busy, idle
+	}
+
+	if idle.Jobs != 1 || idle.Idle <= 0 {
+		t.Fatalf("expected finished worker to be idle, got %+v", idle)
+	}
+
+	if busy.Busy <= idle.Busy {
+		t.Fatalf("expected running worker to be busier, got %+v", workers)
+	}
+
+	close(release)
+	running.Wait()
+}
+
+// starter records the functions started by slots, to run them in order on the test's goroutine.
+type starter struct {
+	started []func()
+}
+
+func (starter *starter) start(serve func()) {
+	starter.started = append(starter.started, serve)
+}
+
+func (starter *starter) run() {
+	for len(starter.started) > 0 {
+		serve := starter.started[0]
+		starter.started = starter.started[1:]
+
+		serve()
+	}
+}
+
+// queueTagged occupies all free slots and queues waiters for the given tags in order.
+// Once the started functions ran, the returned slice holds the tags in the order, the waiters got their slots.
+func queueTagged(slots *slots, tags ...string) *[]string {
+	for slots.used < slots.limit {
+		slots.enqueue(tagged(""), accessShared, func(int) {}, func() {})
+	}
+
+	order := &[]string{}
+
+	for _, tag := range tags {
+		slots.enqueue(tagged(tag), accessShared, func(int) { *order = append(*order, tag) }, func() {})
+	}
+
+	return order
+}
+
+func countTag(tags []string, tag string) int {
+	count := 0
+
+	for _, other := range tags {
+		if other == tag {
+			count++
+		}
+	}
+
+	return count
+}
+
+func tagged(tag string) JobInfo {
+	return JobInfo{Index: 0, Tag: tag, Name: ""}
+}
@@ -0,0 +1,137 @@
+package nursery
+
+import "container/heap"
+
+// MergeSorted waits for the source, whose jobs each return a sorted slice, and merges them into one sorted slice,
+// e.g. for workers sorting chunks of a larger input. Elements, that compare equal, may end up in any order.
+// The slices are merged in O(n log k) for k slices with n elements in total, so no final sort is required.
+// It merges once the source is finished, see [WithSortedMerge] to merge the slices while they are collected.
+func MergeSorted[T any](source Source[[]T], compare func(a, b T) int) []T {
+	if compare == nil {
+		panic("compare must not be nil")
+	}
+
+	collected := source.Wait()
+
+	total := 0
+	runs := &runs[T]{heads: make([][]T, 0, len(collected)), compare: compare}
+
+	for _, run := range collected {
+		total += len(run)
+
+		if len(run) > 0 {
+			runs.heads = append(runs.heads, run)
+		}
+	}
+
+	heap.Init(runs)
+
+	merged := make([]T, 0, total)
+
+	for runs.Len() > 0 {
+		smallest := runs.heads[0]
+		merged = append(merged, smallest[0])
+
+		if len(smallest) == 1 {
+			heap.Pop(runs)
+
+			continue
+		}
+
+		runs.heads[0] = smallest[1:]
+		heap.Fix(runs, 0)
+	}
+
+	return merged
+}
+
+// runs is a min-heap of the sorted slices, that are not merged yet, ordered by their first element.
+type runs[T any] struct {
+	heads   [][]T
+	compare func(a, b T) int
+}
+
+func (runs *runs[T]) Len() int {
+	return len(runs.heads)
+}
+
+func (runs *runs[T]) Less(i, j int) bool {
+	return runs.compare(runs.heads[i][0], runs.heads[j][0]) < 0
+}
+
+func (runs *runs[T]) Swap(i, j int) {
+	runs.heads[i], runs.heads[j] = runs.heads[j], runs.heads[i]
+}
+
+func (runs *runs[T]) Push(run any) {
+	typed, _ := run.([]T)
+	runs.heads = append(runs.heads, typed)
+}
+
+func (runs *runs[T]) Pop() any {
+	last := runs.heads[len(runs.heads)-1]
+	runs.heads = runs.heads[:len(runs.heads)-1]
+
+	return last
+}
+
+// merger merges the results of a nursery during collection, see [WithSortedMerge].
+type merger[R any] interface {
+	add(result R)
+	merged() []R
+}
+
+// sortedRuns merges sorted slices as they are collected, keeping a stack of merged runs,
+// whose lengths decrease from the bottom to the top, so every element is merged O(log k) times for k slices.
+type sortedRuns[T any] struct {
+	stack   [][]T
+	compare func(a, b T) int
+}
+
+func (runs *sortedRuns[T]) add(run []T) {
+	if len(run) == 0 {
+		return
+	}
+
+	runs.stack = append(runs.stack, run)
+
+	for top := len(runs.stack) - 1; top > 0 && len(runs.stack[top-1]) <= len(runs.stack[top]); top-- {
+		runs.stack[top-1] = mergeRuns(runs.stack[top-1], runs.stack[top], runs.compare)
+		runs.stack = runs.stack[:top]
+	}
+}
+
+// merged returns the single sorted slice of all runs.
+func (runs *sortedRuns[T]) merged() [][]T {
+	merged := []T{}
+
+	for i := len(runs.stack) - 1; i >= 0; i-- {
+		merged = mergeRuns(runs.stack[i], merged, runs.compare)
+	}
+
+	return [][]T{merged}
+}
+
+// mergeRuns merges two sorted slices, elements of the first one come first, if they compare equal.
+func mergeRuns[T any](first, second []T, compare func(a, b T) int) []T {
+	merged := make([]T, 0, len(first)+len(second))
+
+	for len(first) > 0 && len(second) > 0 {
+		if compare(first[0], second[0]) <= 0 {
+			merged, first = append(merged, first[0]), first[1:]
+		} else {
+			merged, second = append(merged, second[0]), second[1:]
+		}
+	}
+
+	return append(append(merged, first...), second...)
+}
+
+// newMerger returns the merger of a nursery, if it merges its results, see [WithSortedMerge].
+func newMerger[R any](cfg config) merger[R] {
+	if factory := hook[func() merger[R]](cfg.merger, "WithSortedMerge"); factory != nil {
+		return factory()
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package nursery_test
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestMergeSorted(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[[]int]()
+
+	for _, chunk := range [][]int{{5, 1, 9}, {}, {4, 4, 2}, {8, 3, 7, 6}} {
+		unbounded.Go(func() []int {
+			slices.Sort(chunk)
+
+			return chunk
+		})
+	}
+
+	merged := nursery.MergeSorted(unbounded, cmp.Compare[int])
+	if !slices.Equal(merged, []int{1, 2, 3, 4, 4, 5, 6, 7, 8, 9}) {
+		t.Fatalf("expected all elements in order, got %v", merged)
+	}
+}
+
+func TestMergeSorted_Compare(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[[]string]()
+	unbounded.Go(func() []string { return []string{"c", "a"} })
+	unbounded.Go(func() []string { return []string{"d", "b"} })
+
+	descending := func(a, b string) int { return strings.Compare(b, a) }
+
+	if merged := nursery.MergeSorted(unbounded, descending); !slices.Equal(merged, []string{"d", "c", "b", "a"}) {
+		t.Fatalf("expected the elements in descending order, got %v", merged)
+	}
+}
+
+func TestMergeSorted_Empty(t *testing.T) {
+	t.Parallel()
+
+	if merged := nursery.MergeSorted(nursery.NewUnbounded[[]int](), cmp.Compare[int]); merged == nil || len(merged) != 0 {
+		t.Fatalf("expected no elements, got %#v", merged)
+	}
+}
+
+func TestWithSortedMerge(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBoundedSimple[[]int](3, nursery.WithSortedMerge(cmp.Compare[int]))
+
+	for _, chunk := range [][]int{{5, 3, 9}, {}, {8, 1}, {2, 7, 4, 6}, {0}} {
+		bounded.Go(func() []int {
+			slices.Sort(chunk)
+
+			return chunk
+		})
+	}
+
+	if results := bounded.Wait(); len(results) != 1 || !slices.Equal(results[0], []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Fatalf("expected a single sorted slice, got %v", results)
+	}
+}
+
+func TestWithSortedMerge_KeepsCollectionOrderOfEqualElements(t *testing.T) {
+	t.Parallel()
+
+	type element struct{ key, run int }
+
+	unbounded := nursery.NewUnbounded[[]element](nursery.WithSortedMerge(func(a, b element) int {
+		return cmp.Compare(a.key, b.key)
+	}))
+
+	// Jobs run one after another, so the runs are collected in order.
+	for run := range 8 {
+		done := make(chan struct{})
+
+		unbounded.Go(func() []element {
+			defer close(done)
+
+			return []element{{key: 1, run: run}, {key: 1 + run, run: run}}
+		})
+
+		<-done
+
+		for unbounded.Stats().Results <= int64(run) {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	merged := unbounded.Wait()[0]
+	if len(merged) != 16 {
+		t.Fatalf("expected all elements, got %v", merged)
+	}
+
+	previous := element{key: -1, run: -1}
+	for _, current := range merged {
+		if current.key < previous.key || current.key == previous.key && current.run < previous.run {
+			t.Fatalf("expected elements sorted by key and equal ones in collection order, got %v", merged)
+		}
+
+		previous = current
+	}
+}
+
+func TestWithSortedMerge_Empty(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[[]int](nursery.WithSortedMerge(cmp.Compare[int]))
+
+	if results := unbounded.Wait(); len(results) != 1 || len(results[0]) != 0 {
+		t.Fatalf("expected a single empty slice, got %v", results)
+	}
+}
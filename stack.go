@@ -0,0 +1,64 @@
+package nursery
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// StackCapture configures, how the stack of a panicking job is captured for its [PanicError].
+type StackCapture int
+
+const (
+	// StackFull captures the complete stack of the goroutine, like [debug.Stack].
+	StackFull StackCapture = iota
+	// StackTrimmed captures only the frames between the panic and the job, without the runtime's and the nursery's.
+	StackTrimmed
+	// StackNone does not capture stacks, which is the cheapest for many failing jobs.
+	StackNone
+)
+
+// captureStack captures the stack of a panic, it must be called by the function deferred to recover it.
+// It must not panic itself, so the mode is validated by [WithStackCapture].
+func captureStack(mode StackCapture) []byte {
+	switch mode {
+	case StackFull:
+		return debug.Stack()
+	case StackTrimmed:
+		return trimmedStack()
+	case StackNone:
+		return nil
+	default:
+		return nil
+	}
+}
+
+// trimmedStack formats the frames after the runtime's panic handling, up to the first frame of a nursery.
+func trimmedStack() []byte {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs)])
+
+	var (
+		stack    strings.Builder
+		panicked bool
+	)
+
+	for {
+		frame, more := frames.Next()
+
+		switch {
+		case !panicked:
+			panicked = frame.Function == "runtime.gopanic"
+		case strings.HasPrefix(frame.Function, "github.com/lukasngl/nursery.(*"),
+			strings.HasPrefix(frame.Function, "github.com/lukasngl/nursery.labeled"):
+			return []byte(stack.String())
+		default:
+			fmt.Fprintf(&stack, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		}
+
+		if !more {
+			return []byte(stack.String())
+		}
+	}
+}
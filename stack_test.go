@@ -0,0 +1,71 @@
+package nursery
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCaptureStack(t *testing.T) {
+	t.Parallel()
+
+	full := panicking(StackFull)
+	if !strings.Contains(full, "runtime/debug.Stack") || !strings.Contains(full, "nursery.explode") {
+		t.Fatalf("expected the full stack, got %s", full)
+	}
+
+	trimmed := panicking(StackTrimmed)
+	if strings.Contains(trimmed, "runtime.") || !strings.HasPrefix(trimmed, "github.com/lukasngl/nursery.explode") {
+		t.Fatalf("expected the stack to start at the panic, got %s", trimmed)
+	}
+
+	if strings.Contains(trimmed, "(*Unbounded[...])") {
+		t.Fatalf("expected the stack to end at the job, got %s", trimmed)
+	}
+
+	if none := panicking(StackNone); none != "" {
+		t.Fatalf("expected no stack, got %s", none)
+	}
+}
+
+// panicking captures the stack of a job panicking in an unbounded nursery.
+func panicking(mode StackCapture) string {
+	stacks := make(chan string, 1)
+
+	WithUnbounded(func(Go Go[int]) {
+		Go(func() int {
+			defer func() {
+				recover()
+
+				stacks <- string(captureStack(mode))
+			}()
+
+			return explode()
+		})
+	})
+
+	return <-stacks
+}
+
+func explode() int {
+	panic("boom")
+}
+
+func TestWithStackCapture_Unknown(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recovered := recover(); recovered != "unknown stack capture 7" {
+			t.Fatalf("expected the option to reject the mode, got %v", recovered)
+		}
+	}()
+
+	WithStackCapture(StackCapture(7))
+}
+
+func TestCaptureStack_Unknown(t *testing.T) {
+	t.Parallel()
+
+	if stack := captureStack(StackCapture(7)); stack != nil {
+		t.Fatalf("expected no stack for an unknown mode, got %s", stack)
+	}
+}
@@ -0,0 +1,66 @@
+package nursery
+
+import "sync"
+
+// starts tracks the jobs, that were submitted, but did not start yet, to notify waiters once all started.
+// The zero value has no pending jobs.
+type starts struct {
+	mx      sync.Mutex
+	pending int
+	waiters []chan struct{}
+}
+
+// submit marks a job as pending.
+func (starts *starts) submit() {
+	starts.mx.Lock()
+	defer starts.mx.Unlock()
+
+	starts.pending++
+}
+
+// begin marks a pending job as started, or skipped, and notifies the waiters, if it was the last one.
+func (starts *starts) begin() {
+	starts.mx.Lock()
+	defer starts.mx.Unlock()
+
+	starts.pending--
+	if starts.pending > 0 {
+		return
+	}
+
+	for _, waiter := range starts.waiters {
+		close(waiter)
+	}
+
+	starts.waiters = nil
+}
+
+// wait returns a channel, that is closed once no job is pending anymore.
+func (starts *starts) wait() <-chan struct{} {
+	starts.mx.Lock()
+	defer starts.mx.Unlock()
+
+	waiter := make(chan struct{})
+
+	if starts.pending == 0 {
+		close(waiter)
+	} else {
+		starts.waiters = append(starts.waiters, waiter)
+	}
+
+	return waiter
+}
+
+// Started returns a channel, that is closed once every job submitted so far has begun executing,
+// e.g. for warm-up phases, that must know all workers are running, or for tests.
+// Jobs, that are submitted before the channel is closed, keep it open until they started, too.
+// Unlike [Unbounded.Done], it does not make the nursery reject new jobs.
+func (nursery *Unbounded[R]) Started() <-chan struct{} {
+	return nursery.starts.wait()
+}
+
+// Started is like [Unbounded.Started], but jobs, that were skipped, count as started,
+// e.g. as the nursery was stopped before they got a slot.
+func (nursery *Bounded[R]) Started() <-chan struct{} {
+	return nursery.inner.starts.wait()
+}
@@ -0,0 +1,104 @@
+package nursery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestUnbounded_Started(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+	release := make(chan struct{})
+
+	for range 3 {
+		unbounded.Go(func() int {
+			<-release
+
+			return 1
+		})
+	}
+
+	select {
+	case <-unbounded.Started():
+	case <-time.After(time.Second):
+		t.Fatal("expected all jobs to start")
+	}
+
+	close(release)
+	unbounded.Wait()
+}
+
+func TestBounded_StartedWaitsForSlots(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1)
+	release := make(chan struct{})
+
+	for range 2 {
+		bounded.Go(func() int {
+			<-release
+
+			return 1
+		})
+	}
+
+	started := bounded.Started()
+
+	select {
+	case <-started:
+		t.Fatal("expected the queued job not to start")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("expected the queued job to start once a slot was free")
+	}
+
+	bounded.Wait()
+}
+
+func TestBounded_StartedCountsSkipped(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1)
+	release := make(chan struct{})
+
+	bounded.Go(func() int {
+		<-release
+
+		return 1
+	})
+	bounded.Go(func() int { return 2 })
+
+	started := bounded.Started()
+	bounded.Stop()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("expected the skipped job to count as started")
+	}
+
+	close(release)
+	bounded.Wait()
+}
+
+func TestStarted_WithoutJobs(t *testing.T) {
+	t.Parallel()
+
+	var unbounded nursery.Unbounded[int]
+
+	select {
+	case <-unbounded.Started():
+	default:
+		t.Fatal("expected no job to be pending")
+	}
+}
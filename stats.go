@@ -0,0 +1,89 @@
+package nursery
+
+import (
+	"expvar"
+	"sync"
+)
+
+// Stats is a snapshot of a nursery's jobs.
+// Queued jobs are submitted, but not running, e.g. waiting for a slot or to be requeued.
+// Limit is the number of jobs allowed to run in parallel, or 0 if there is no limit,
+// Peak the most jobs that ran in parallel so far, e.g. to verify whether the limit is ever reached.
+// Results counts the collected results, including those not retained by sampling, see [WithSample].
+// Skipped counts the jobs of a [Bounded] nursery, that never ran, e.g. as its context was cancelled.
+type Stats struct {
+	Running   int64 `json:"running"`
+	Queued    int64 `json:"queued"`
+	Completed int64 `json:"completed"`
+	Skipped   int64 `json:"skipped"`
+	Results   int64 `json:"results"`
+	Limit     int   `json:"limit"`
+	Peak      int64 `json:"peak"`
+}
+
+// Stats returns a snapshot of the nursery's jobs.
+func (nursery *Unbounded[R]) Stats() Stats {
+	running := nursery.running.Load()
+
+	return Stats{
+		Running:   running,
+		Queued:    max(nursery.active.Load()-running, 0),
+		Completed: nursery.completed.Load(),
+		Skipped:   nursery.skipped.Load(),
+		Results:   nursery.collected.Load(),
+		Limit:     0,
+		Peak:      nursery.tally.highest(),
+	}
+}
+
+// Stats returns a snapshot of the nursery's jobs.
+// While ramping up, see [WithRampUp], the limit is the current number of slots.
+func (nursery *Bounded[R]) Stats() Stats {
+	stats := nursery.inner.Stats()
+	stats.Limit = nursery.slots.size()
+
+	return stats
+}
+
+// Skipped returns the number of jobs, that never ran, so lost work can be detected once Wait returned:
+// scheduled jobs are dropped, once the nursery's context is done or it is stopped, see [Bounded.Stop],
+// as well as jobs dropped by [QueueDrop] and jobs of cancelled futures, see [Future.Cancel].
+func (nursery *Bounded[R]) Skipped() int64 {
+	return nursery.inner.skipped.Load()
+}
+
+// Stats returns a snapshot of the jobs of all shards.
+// The peak is the one of the busiest shard, as the shards do not track their jobs together.
+func (nursery *Sharded[R]) Stats() Stats {
+	var total Stats
+
+	for _, shard := range nursery.shards {
+		stats := shard.Stats()
+		total.Running += stats.Running
+		total.Queued += stats.Queued
+		total.Completed += stats.Completed
+		total.Skipped += stats.Skipped
+		total.Results += stats.Results
+		total.Peak = max(total.Peak, stats.Peak)
+	}
+
+	return total
+}
+
+var (
+	nurseries     *expvar.Map
+	nurseriesOnce sync.Once
+)
+
+// publish publishes the stats under the name in the nurseries expvar map, unless the name is empty.
+func publish(name string, stats func() Stats) {
+	if name == "" {
+		return
+	}
+
+	nurseriesOnce.Do(func() {
+		nurseries = expvar.NewMap("nurseries")
+	})
+
+	nurseries.Set(name, expvar.Func(func() any { return stats() }))
+}
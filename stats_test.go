@@ -0,0 +1,97 @@
+package nursery_test
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"sync"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBounded_Stats(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1)
+
+	started, release := make(chan struct{}), make(chan struct{})
+
+	bounded.Go(func() int {
+		close(started)
+		<-release
+
+		return 1
+	})
+	bounded.Go(func() int { return 2 })
+
+	<-started
+
+	if stats := bounded.Stats(); stats.Running != 1 || stats.Queued != 1 || stats.Limit != 1 {
+		t.Fatalf("expected one running and one queued job, got %+v", stats)
+	}
+
+	close(release)
+	bounded.Wait()
+
+	if stats := bounded.Stats(); stats.Running != 0 || stats.Queued != 0 || stats.Completed != 2 {
+		t.Fatalf("expected all jobs to be completed, got %+v", stats)
+	}
+}
+
+func TestStats_Peak(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBoundedSimple[int](3)
+
+	var started sync.WaitGroup
+
+	release := make(chan struct{})
+
+	for job := range 2 {
+		started.Add(1)
+
+		bounded.Go(func() int {
+			started.Done()
+			<-release
+
+			return job
+		})
+	}
+
+	started.Wait()
+	close(release)
+
+	for job := range 4 {
+		bounded.Go(func() int { return job })
+	}
+
+	bounded.Wait()
+
+	// The bound of 3 may be reached by the later jobs, but never exceeded.
+	if stats := bounded.Stats(); stats.Peak < 2 || stats.Peak > int64(stats.Limit) {
+		t.Fatalf("expected a peak between 2 and the limit, got %+v", stats)
+	}
+}
+
+func TestWithExpvar(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int](nursery.WithExpvar("stats-test"))
+	unbounded.Go(func() int { return 1 })
+	unbounded.Wait()
+
+	nurseries, ok := expvar.Get("nurseries").(*expvar.Map)
+	if !ok {
+		t.Fatal("expected nurseries to be published")
+	}
+
+	var stats nursery.Stats
+	if err := json.Unmarshal([]byte(nurseries.Get("stats-test").String()), &stats); err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.Completed != 1 {
+		t.Fatalf("expected the published stats to count the job, got %+v", stats)
+	}
+}
@@ -0,0 +1,119 @@
+package nursery
+
+import "iter"
+
+// stream hands results to the iterator returned by Stream.
+type stream[R any] struct {
+	results chan R
+	// done is closed, once the iterator stopped, so results are discarded.
+	done chan struct{}
+}
+
+// Stream makes the nursery reject new jobs, like Wait, and returns an iterator over the results as they arrive,
+// e.g. to process the results of a large fan-out incrementally, instead of holding all of them until Wait returns.
+// Results collected before are yielded first. Streamed results are not retained, so Wait does not return them,
+// and they are neither ordered, finalized nor deduplicated, see [WithSubmissionOrder], [WithFinalizer] and [WithDedup].
+//
+// Jobs block until the consumer takes their result. Once the consumer stops early, the remaining results are discarded.
+// Either way, the iterator returns only once all jobs are finished, and rethrows their panics like Wait.
+// The iterator can be used once, and must not be used concurrently with Wait.
+func (nursery *Unbounded[R]) Stream() iter.Seq[R] {
+	return nursery.streamUntil(nursery.close, nursery.Done)
+}
+
+// Stream is like [Unbounded.Stream].
+func (nursery *Bounded[R]) Stream() iter.Seq[R] {
+	return nursery.inner.streamUntil(nursery.inner.close, nursery.Done)
+}
+
+// streamUntil returns an iterator over the results, that closes the nursery once it is used,
+// and waits for it via done, see [Unbounded.Done].
+func (nursery *Unbounded[R]) streamUntil(closeNursery func(), done func() <-chan struct{}) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		closeNursery()
+
+		backlog, stream := nursery.subscribe()
+
+		// The stream ends, once all results are collected, which requires waiting for the jobs.
+		finished := done()
+
+		defer func() {
+			close(stream.done)
+			<-finished
+			nursery.rethrow()
+		}()
+
+		for _, result := range backlog {
+			if !yield(result) {
+				return
+			}
+		}
+
+		for result := range stream.results {
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// subscribe takes the results collected so far, and makes the collector hand further ones to the returned stream.
+func (nursery *Unbounded[R]) subscribe() ([]R, *stream[R]) {
+	nursery.streamMx.Lock()
+	defer nursery.streamMx.Unlock()
+
+	if nursery.stream != nil {
+		panic("nursery is already streamed")
+	}
+
+	nursery.stream = &stream[R]{results: make(chan R), done: make(chan struct{})}
+
+	if nursery.collectorDone {
+		// The collector is finished already.
+		close(nursery.stream.results)
+	}
+
+	backlog := nursery.results
+	nursery.results, nursery.indices = []R{}, nil
+
+	return backlog, nursery.stream
+}
+
+// retain keeps the result for Wait, or hands it to the stream, if the nursery is streamed.
+func (nursery *Unbounded[R]) retain(collected indexed[R]) {
+	nursery.streamMx.Lock()
+
+	stream := nursery.stream
+	if stream == nil {
+		nursery.results = append(nursery.results, collected.result)
+
+		if nursery.ordered {
+			nursery.indices = append(nursery.indices, collected.index)
+		}
+
+		nursery.retainFailure(collected)
+
+		nursery.streamMx.Unlock()
+
+		return
+	}
+
+	nursery.streamMx.Unlock()
+
+	select {
+	case stream.results <- collected.result:
+	case <-stream.done:
+	}
+}
+
+// endStream closes the stream, once the collector is finished.
+func (nursery *Unbounded[R]) endStream() {
+	nursery.streamMx.Lock()
+	defer nursery.streamMx.Unlock()
+
+	nursery.collectorDone = true
+
+	if nursery.stream != nil {
+		close(nursery.stream.results)
+	}
+}
@@ -0,0 +1,77 @@
+package nursery_test
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+// streamer is a nursery, whose results can be streamed.
+type streamer interface {
+	Go(job func() int)
+	Stream() iter.Seq[int]
+	Wait() []int
+}
+
+func TestStream_YieldsResultsAsTheyArrive(t *testing.T) {
+	t.Parallel()
+
+	for name, newNursery := range map[string]func() streamer{
+		"unbounded": func() streamer { return nursery.NewUnbounded[int]() },
+		"bounded":   func() streamer { return nursery.NewBounded[int](context.TODO(), 2) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			subject := newNursery()
+			release := make(chan struct{})
+
+			subject.Go(func() int { return 1 })
+			subject.Go(func() int {
+				<-release
+
+				return 2
+			})
+
+			var results []int
+
+			for result := range subject.Stream() {
+				// The second job only finishes, once the first result was consumed.
+				if result == 1 {
+					close(release)
+				}
+
+				results = append(results, result)
+			}
+
+			if !slices.Equal(results, []int{1, 2}) {
+				t.Fatalf("expected the results as they arrive, got %v", results)
+			}
+
+			if retained := subject.Wait(); len(retained) != 0 {
+				t.Fatalf("expected streamed results not to be retained, got %v", retained)
+			}
+		})
+	}
+}
+
+func TestStream_StopsEarly(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+
+	for i := range 5 {
+		unbounded.Go(func() int { return i })
+	}
+
+	for range unbounded.Stream() {
+		break
+	}
+
+	if stats := unbounded.Stats(); stats.Completed != 5 || stats.Running != 0 {
+		t.Fatalf("expected all jobs to finish before the iterator returns, got %+v", stats)
+	}
+}
@@ -0,0 +1,89 @@
+package nursery_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithSubmitterRuns_RunsOnSubmitter(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithSubmitterRuns())
+
+	ran := false
+	bounded.Go(func() int {
+		ran = true
+
+		return 1
+	})
+
+	// The job ran before Go returned, so no synchronization is required.
+	if !ran {
+		t.Fatal("expected the job to run on the submitting goroutine")
+	}
+
+	if results := bounded.Wait(); len(results) != 1 {
+		t.Fatalf("expected the job's result, got %v", results)
+	}
+
+	if created := bounded.Goroutines().Created; created != 0 {
+		t.Fatalf("expected no worker to be started, got %d", created)
+	}
+}
+
+func TestWithSubmitterRuns_QueuesWhileBusy(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithSubmitterRuns())
+
+	started, release := make(chan struct{}), make(chan struct{})
+
+	go bounded.Go(func() int {
+		close(started)
+		<-release
+
+		return 1
+	})
+
+	<-started
+
+	// The slot is busy, so the job is queued instead of blocking the submitter.
+	bounded.Go(func() int { return 2 })
+	close(release)
+
+	if results := bounded.Wait(); len(results) != 2 {
+		t.Fatalf("expected both jobs to run, got %v", results)
+	}
+}
+
+func TestWithSubmitterRuns_HandsOverLane(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1, nursery.WithSubmitterRuns())
+
+	queued := make(chan struct{})
+
+	bounded.Go(func() int {
+		// Queue another job from a different goroutine, while the slot is busy.
+		go func() {
+			defer close(queued)
+
+			bounded.Go(func() int { return 2 })
+		}()
+
+		for bounded.Stats().Queued < 1 {
+			runtime.Gosched()
+		}
+
+		return 1
+	})
+
+	<-queued
+
+	if results := bounded.Wait(); len(results) != 2 {
+		t.Fatalf("expected the queued job to run once the slot was free, got %v", results)
+	}
+}
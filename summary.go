@@ -0,0 +1,113 @@
+package nursery
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Summary describes how the jobs of a nursery behaved, e.g. to log a fan-out in a single line.
+// Wall is the time from creating the nursery until all jobs finished,
+// Peak the most jobs running in parallel, and Queued the total time jobs waited to start,
+// e.g. for a slot or a delay, see [WithStartJitter].
+// The outcomes are counted like the ones of [AuditRecord]: Completed includes Failed jobs.
+type Summary struct {
+	Wall      time.Duration `json:"wall"`
+	Peak      int64         `json:"peak"`
+	Queued    time.Duration `json:"queued"`
+	Completed int64         `json:"completed"`
+	Failed    int64         `json:"failed"`
+	Skipped   int64         `json:"skipped"`
+	Panicked  int64         `json:"panicked"`
+	Exited    int64         `json:"exited"`
+}
+
+// tally accumulates the numbers of a [Summary], while the jobs run.
+type tally struct {
+	// created is the time the nursery was created, ended the time its last job finished.
+	created time.Time
+	ended   time.Time
+	peak    atomic.Int64
+	// queued is the total time, jobs waited to start, in nanoseconds.
+	queued   atomic.Int64
+	failed   atomic.Int64
+	panicked atomic.Int64
+	exited   atomic.Int64
+}
+
+func newTally() *tally {
+	return &tally{
+		created:  time.Now(),
+		ended:    time.Time{},
+		peak:     atomic.Int64{},
+		queued:   atomic.Int64{},
+		failed:   atomic.Int64{},
+		panicked: atomic.Int64{},
+		exited:   atomic.Int64{},
+	}
+}
+
+// running raises the peak to the number of running jobs, if it is higher.
+func (tally *tally) running(running int64) {
+	for peak := tally.peak.Load(); running > peak && !tally.peak.CompareAndSwap(peak, running); {
+		peak = tally.peak.Load()
+	}
+}
+
+// highest returns the peak, or 0 if the nursery is the zero value, that was not used yet.
+func (tally *tally) highest() int64 {
+	if tally == nil {
+		return 0
+	}
+
+	return tally.peak.Load()
+}
+
+// wait adds the time since the job was submitted, once it starts.
+func (tally *tally) wait(submitted, started time.Time) {
+	tally.queued.Add(int64(started.Sub(submitted)))
+}
+
+// count counts the outcome of a finished job, whose result reported the error, see [errorOf].
+func (tally *tally) count(kind EventKind, err error) {
+	switch {
+	case kind == EventPanic:
+		tally.panicked.Add(1)
+	case kind == EventExit:
+		tally.exited.Add(1)
+	case kind == EventFinish && err != nil:
+		tally.failed.Add(1)
+	}
+}
+
+// markRunning marks a job as running.
+func (nursery *Unbounded[R]) markRunning() {
+	nursery.tally.running(nursery.running.Add(1))
+}
+
+// WaitSummary is like Wait, but also returns how the jobs behaved.
+func (nursery *Unbounded[R]) WaitSummary() ([]R, Summary) {
+	results := nursery.Wait()
+
+	return results, nursery.summary()
+}
+
+// WaitSummary is like [Unbounded.WaitSummary].
+func (nursery *Bounded[R]) WaitSummary() ([]R, Summary) {
+	results := nursery.Wait()
+
+	return results, nursery.inner.summary()
+}
+
+// summary returns the summary of the jobs, once all of them finished.
+func (nursery *Unbounded[R]) summary() Summary {
+	return Summary{
+		Wall:      nursery.tally.ended.Sub(nursery.tally.created),
+		Peak:      nursery.tally.peak.Load(),
+		Queued:    time.Duration(nursery.tally.queued.Load()),
+		Completed: nursery.completed.Load(),
+		Failed:    nursery.tally.failed.Load(),
+		Skipped:   nursery.skipped.Load(),
+		Panicked:  nursery.tally.panicked.Load(),
+		Exited:    nursery.tally.exited.Load(),
+	}
+}
@@ -0,0 +1,97 @@
+package nursery_test
+
+import (
+	"context"
+	"io"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBounded_WaitSummary(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewBoundedSimple[error](2)
+
+	for range 4 {
+		n.Go(func() error {
+			time.Sleep(5 * time.Millisecond)
+
+			return nil
+		})
+	}
+
+	n.Go(func() error { return io.EOF })
+
+	results, summary := n.WaitSummary()
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %v", results)
+	}
+
+	if summary.Completed != 5 || summary.Failed != 1 || summary.Skipped != 0 || summary.Panicked != 0 {
+		t.Fatalf("expected 5 completed jobs, one of them failed, got %+v", summary)
+	}
+
+	if summary.Peak != 2 {
+		t.Fatalf("expected at most 2 jobs in parallel, got %+v", summary)
+	}
+
+	// With 2 slots, later jobs wait for earlier ones.
+	if summary.Queued < 5*time.Millisecond || summary.Wall < 10*time.Millisecond {
+		t.Fatalf("expected jobs to be queued, got %+v", summary)
+	}
+}
+
+func TestBounded_WaitSummary_Skipped(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	n := nursery.NewBounded[int](ctx, 1)
+	n.Go(func() int {
+		<-n.Context().Done()
+
+		return 0
+	})
+
+	for n.Stats().Running < 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	n.Go(func() int { return 1 })
+	cancel()
+
+	if _, summary := n.WaitSummary(); summary.Completed != 1 || summary.Skipped != 1 {
+		t.Fatalf("expected one completed and one skipped job, got %+v", summary)
+	}
+}
+
+func TestUnbounded_WaitSummary(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewUnbounded[int]()
+	n.Go(func() int { return 1 })
+	n.Go(func() int {
+		runtime.Goexit()
+
+		return 0
+	})
+
+	if _, summary := n.WaitSummary(); summary.Completed != 1 || summary.Exited != 1 || summary.Peak < 1 {
+		t.Fatalf("expected one completed and one exited job, got %+v", summary)
+	}
+}
+
+func TestUnbounded_WaitSummary_ZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var n nursery.Unbounded[int]
+
+	n.Go(func() int { return 1 })
+
+	if _, summary := n.WaitSummary(); summary.Completed != 1 || summary.Wall <= 0 {
+		t.Fatalf("expected one completed job, got %+v", summary)
+	}
+}
@@ -0,0 +1,159 @@
+//go:build go1.25
+
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestSynctest_RampUp(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		t.Helper()
+
+		started := make(chan struct{}, 3)
+		bounded := nursery.NewBounded[int](context.Background(), 3, nursery.WithRampUp(time.Second))
+
+		for range 3 {
+			bounded.Go(func() int {
+				started <- struct{}{}
+
+				time.Sleep(time.Hour)
+
+				return 0
+			})
+		}
+
+		for want := 1; want <= 3; want++ {
+			synctest.Wait()
+
+			if len(started) != want {
+				t.Fatalf("expected %d running jobs after %d ramp up steps, got %d", want, want-1, len(started))
+			}
+
+			time.Sleep(time.Second)
+		}
+
+		bounded.Wait()
+	})
+}
+
+func TestSynctest_RetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := nursery.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     func(int) time.Duration { return time.Minute },
+		Retryable:   nil,
+		Budget:      nil,
+	}
+
+	for name, newNursery := range map[string]func() goer{
+		"Unbounded": func() goer { return nursery.NewUnbounded[error](nursery.WithRetry(policy)) },
+		"Bounded": func() goer {
+			return nursery.NewBounded[error](context.Background(), 1, nursery.WithRetry(policy))
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			synctest.Test(t, func(t *testing.T) {
+				t.Helper()
+
+				start := time.Now()
+				goer := newNursery()
+
+				goer.Go(func() error { return errTransient })
+
+				if results := goer.Wait(); len(results) != 1 || !errors.Is(results[0], errTransient) {
+					t.Fatalf("expected the error of the last attempt, got %v", results)
+				}
+
+				if elapsed := time.Since(start); elapsed != 2*time.Minute {
+					t.Fatalf("expected two backoffs, got %s", elapsed)
+				}
+			})
+		})
+	}
+}
+
+func TestSynctest_IdleTimeout(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		t.Helper()
+
+		bounded := nursery.NewBounded[int](context.Background(), 1, nursery.WithIdleTimeout(time.Minute))
+
+		bounded.Go(func() int { return 1 })
+		synctest.Wait()
+
+		time.Sleep(time.Minute - time.Nanosecond)
+		bounded.Go(func() int { return 2 })
+		synctest.Wait()
+
+		time.Sleep(2 * time.Minute)
+		synctest.Wait()
+		bounded.Go(func() int { return 3 })
+
+		bounded.Wait()
+
+		if stats := bounded.Goroutines(); stats.Created != 2 || stats.Reused != 1 {
+			t.Fatalf("expected the worker to be reused once before it timed out, got %+v", stats)
+		}
+	})
+}
+
+func TestSynctest_Requeue(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		t.Helper()
+
+		start := time.Now()
+		bounded := nursery.NewBounded[time.Duration](context.Background(), 1, nursery.WithPrewarm())
+
+		bounded.GoAttempt(func(attempt *nursery.Attempt) time.Duration {
+			if attempt.Number() == 1 {
+				attempt.Requeue(time.Hour)
+			}
+
+			return time.Since(start)
+		})
+
+		if results := bounded.Wait(); len(results) != 1 || results[0] != time.Hour {
+			t.Fatalf("expected the job to be requeued for an hour, got %v", results)
+		}
+	})
+}
+
+func TestSynctest_ContextTimeout(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		t.Helper()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		bounded := nursery.NewBounded[int](ctx, 1)
+
+		bounded.Go(func() int {
+			<-ctx.Done()
+
+			return 1
+		})
+		bounded.Go(func() int { return 2 })
+
+		if results := bounded.Wait(); len(results) != 1 || results[0] != 1 {
+			t.Fatalf("expected the queued job to be skipped, got %v", results)
+		}
+	})
+}
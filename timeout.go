@@ -0,0 +1,59 @@
+package nursery
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Timeout is the deadline of a job, that the job can extend while it runs,
+// e.g. as it reports progress and legitimately needs more time than its default timeout.
+// It is safe for concurrent use, e.g. by a watchdog of the job.
+type Timeout struct {
+	mx       sync.Mutex
+	deadline time.Time
+	timer    *time.Timer
+}
+
+// WithExtendableTimeout returns a context derived from ctx, that is cancelled once the timeout passed,
+// with [context.DeadlineExceeded] as cause, unless the deadline is extended via the returned [Timeout].
+// As its deadline can change, the context does not report it via Deadline, see [Timeout.Deadline] instead.
+//
+// Calling the returned function cancels the context and stops the timeout.
+func WithExtendableTimeout(ctx context.Context, timeout time.Duration) (context.Context, *Timeout, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	deadline := &Timeout{mx: sync.Mutex{}, deadline: time.Now().Add(timeout), timer: nil}
+	deadline.timer = time.AfterFunc(timeout, func() {
+		cancel(context.DeadlineExceeded)
+	})
+
+	return ctx, deadline, func() {
+		deadline.timer.Stop()
+		cancel(context.Canceled)
+	}
+}
+
+// ExtendDeadline moves the deadline back by the given duration,
+// and reports false, if the deadline already passed or the context was cancelled.
+func (timeout *Timeout) ExtendDeadline(by time.Duration) bool {
+	timeout.mx.Lock()
+	defer timeout.mx.Unlock()
+
+	if !timeout.timer.Stop() {
+		return false
+	}
+
+	timeout.deadline = timeout.deadline.Add(by)
+	timeout.timer.Reset(time.Until(timeout.deadline))
+
+	return true
+}
+
+// Deadline returns the current deadline.
+func (timeout *Timeout) Deadline() time.Time {
+	timeout.mx.Lock()
+	defer timeout.mx.Unlock()
+
+	return timeout.deadline
+}
@@ -0,0 +1,68 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithExtendableTimeout_Expires(t *testing.T) {
+	t.Parallel()
+
+	ctx, _, cancel := nursery.WithExtendableTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	<-ctx.Done()
+
+	if !errors.Is(context.Cause(ctx), context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline to be exceeded, got %v", context.Cause(ctx))
+	}
+}
+
+func TestTimeout_ExtendDeadline(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+
+	results := nursery.WithBounded(context.TODO(), 2, func(Go nursery.Go[time.Duration]) {
+		Go(func() time.Duration {
+			ctx, timeout, cancel := nursery.WithExtendableTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			// Report progress twice, each buying another 10ms.
+			for range 2 {
+				time.Sleep(5 * time.Millisecond)
+
+				if !timeout.ExtendDeadline(10 * time.Millisecond) {
+					t.Error("expected the deadline to be extended")
+				}
+			}
+
+			<-ctx.Done()
+
+			return time.Since(start)
+		})
+	})
+
+	if len(results) != 1 || results[0] < 30*time.Millisecond {
+		t.Fatalf("expected the extended deadline to be met, got %v", results)
+	}
+}
+
+func TestTimeout_CannotExtendPassedDeadline(t *testing.T) {
+	t.Parallel()
+
+	ctx, timeout, cancel := nursery.WithExtendableTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	<-ctx.Done()
+
+	deadline := timeout.Deadline()
+
+	if timeout.ExtendDeadline(time.Hour) || !timeout.Deadline().Equal(deadline) {
+		t.Fatalf("expected the passed deadline to stay, got %v", timeout.Deadline())
+	}
+}
@@ -0,0 +1,123 @@
+package nursery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+)
+
+// tracer records when jobs ran on which worker, to write them as a timeline, see [WithTrace], or a graph, see [WithGraph].
+type tracer struct {
+	mx    sync.Mutex
+	spans []span
+	// parents holds the index of the job, that started a job via a [Scope], by the index of the job.
+	parents map[int]int
+}
+
+// span is a single run of a job, on the worker named by track.
+// Jobs of an [Unbounded] nursery run on their own goroutine, so their track is empty and each gets its own thread.
+type span struct {
+	job   JobInfo
+	track string
+	start time.Time
+	end   time.Time
+}
+
+// traceEvent is an event of the Chrome trace event format, which is also understood by Perfetto.
+type traceEvent struct {
+	Name      string         `json:"name"`
+	Phase     string         `json:"ph"`
+	Timestamp float64        `json:"ts"`
+	Duration  float64        `json:"dur,omitempty"`
+	Process   int            `json:"pid"`
+	Thread    int            `json:"tid"`
+	Args      map[string]any `json:"args,omitempty"`
+}
+
+func newTracer() *tracer {
+	return &tracer{mx: sync.Mutex{}, spans: nil, parents: map[int]int{}}
+}
+
+// trace records the run of the job, if the nursery writes a trace.
+func (nursery *Unbounded[R]) trace(job JobInfo, track string, start time.Time) {
+	if nursery.tracer == nil {
+		return
+	}
+
+	end := time.Now()
+
+	nursery.tracer.mx.Lock()
+	defer nursery.tracer.mx.Unlock()
+
+	nursery.tracer.spans = append(nursery.tracer.spans, span{job: job, track: track, start: start, end: end})
+}
+
+// write writes the recorded spans as a trace, with a thread per track.
+// Timestamps are relative to the first span.
+func (tracer *tracer) write(writer io.Writer) error {
+	tracer.mx.Lock()
+	defer tracer.mx.Unlock()
+
+	slices.SortStableFunc(tracer.spans, func(a, b span) int {
+		return a.start.Compare(b.start)
+	})
+
+	events := make([]traceEvent, 0, 2*len(tracer.spans))
+	threads, tracks := map[string]int{}, 0
+
+	for _, span := range tracer.spans {
+		thread, ok := threads[span.track]
+		if !ok || span.track == "" {
+			tracks++
+			thread = tracks
+
+			track := span.track
+			if track == "" {
+				track = span.job.String()
+			} else {
+				threads[span.track] = thread
+			}
+
+			events = append(events, traceEvent{
+				Name:      "thread_name",
+				Phase:     "M",
+				Timestamp: 0,
+				Duration:  0,
+				Process:   1,
+				Thread:    thread,
+				Args:      map[string]any{"name": track},
+			})
+		}
+
+		args := map[string]any{"index": span.job.Index}
+		if span.job.Tag != "" {
+			args["tag"] = span.job.Tag
+		}
+
+		events = append(events, traceEvent{
+			Name:      span.job.String(),
+			Phase:     "X",
+			Timestamp: micros(span.start.Sub(tracer.spans[0].start)),
+			Duration:  micros(span.end.Sub(span.start)),
+			Process:   1,
+			Thread:    thread,
+			Args:      args,
+		})
+	}
+
+	err := json.NewEncoder(writer).Encode(struct {
+		TraceEvents []traceEvent `json:"traceEvents"`
+	}{TraceEvents: events})
+	if err != nil {
+		return fmt.Errorf("writing trace: %w", err)
+	}
+
+	return nil
+}
+
+func micros(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Microsecond)
+}
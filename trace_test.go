@@ -0,0 +1,122 @@
+package nursery_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+type traceEvent struct {
+	Name  string         `json:"name"`
+	Phase string         `json:"ph"`
+	TS    float64        `json:"ts"`
+	Dur   float64        `json:"dur"`
+	TID   int            `json:"tid"`
+	Args  map[string]any `json:"args"`
+}
+
+func TestWithTrace_Bounded(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	bounded := nursery.NewBounded[int](context.TODO(), 2, nursery.WithTrace(&buffer))
+
+	for i := range 6 {
+		bounded.Go(func() int {
+			time.Sleep(time.Millisecond)
+
+			return i
+		})
+	}
+
+	bounded.Wait()
+
+	spans, threads := decodeTrace(t, buffer.Bytes())
+
+	if len(spans) != 6 {
+		t.Fatalf("expected a span per job, got %v", spans)
+	}
+
+	names := slices.Sorted(maps.Values(threads))
+
+	if !slices.Equal(names, []string{"worker 0", "worker 1"}) {
+		t.Fatalf("expected a thread per worker, got %v", names)
+	}
+
+	for _, span := range spans {
+		if _, ok := threads[span.TID]; !ok || span.Dur < 1000 {
+			t.Fatalf("expected the span to run on a worker for at least a millisecond, got %+v", span)
+		}
+	}
+}
+
+func TestWithTrace_Unbounded(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	unbounded := nursery.NewUnbounded[int](nursery.WithTrace(&buffer))
+	unbounded.GoNamed("first", func() int { return 1 })
+	unbounded.GoNamed("second", func() int { return 2 })
+	unbounded.Wait()
+
+	spans, threads := decodeTrace(t, buffer.Bytes())
+
+	if len(spans) != 2 || len(threads) != 2 || spans[0].TID == spans[1].TID {
+		t.Fatalf("expected every job on its own thread, got %v and %v", spans, threads)
+	}
+}
+
+func TestWithTrace_Sharded(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+
+	sharded := nursery.NewSharded[int](3, nursery.WithTrace(&buffer))
+
+	for i := range 6 {
+		sharded.Go(func() int { return i })
+	}
+
+	sharded.Wait()
+
+	if spans, _ := decodeTrace(t, buffer.Bytes()); len(spans) != 6 {
+		t.Fatalf("expected a single trace with the spans of all shards, got %v", spans)
+	}
+}
+
+// decodeTrace returns the spans of the trace, and the names of its threads by id.
+func decodeTrace(t *testing.T, data []byte) ([]traceEvent, map[int]string) {
+	t.Helper()
+
+	var trace struct {
+		TraceEvents []traceEvent `json:"traceEvents"`
+	}
+
+	if err := json.Unmarshal(data, &trace); err != nil {
+		t.Fatalf("expected a single trace, got %v: %s", err, data)
+	}
+
+	var spans []traceEvent
+
+	threads := map[int]string{}
+
+	for _, event := range trace.TraceEvents {
+		switch event.Phase {
+		case "X":
+			spans = append(spans, event)
+		case "M":
+			name, _ := event.Args["name"].(string)
+			threads[event.TID] = name
+		}
+	}
+
+	return spans, threads
+}
@@ -0,0 +1,35 @@
+package nursery
+
+// TryGo is like [Unbounded.Go], but returns [ErrClosed], once the nursery is waited for, instead of panicking,
+// e.g. for library code, that is handed a nursery, which may be waited for concurrently.
+func (nursery *Unbounded[R]) TryGo(job func() R) error {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	return nursery.tryStartSoon(JobInfo{Index: 0, Tag: "", Name: ""}, job)
+}
+
+// TryGo is like [Bounded.Go], but returns why the job was not accepted, instead of panicking:
+// [ErrClosed], once the nursery is waited for, [ErrAborted], once it is stopped or cancelled,
+// [ErrQueueFull], once its queue is full, see [WithQueueLimit],
+// or the rejection of its admission, see [WithAdmission].
+// It never blocks for a full queue, even with [QueueBlock].
+func (nursery *Bounded[R]) TryGo(job func() R) error {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	return nursery.trySubmit(nursery.slots, JobInfo{Index: 0, Tag: "", Name: ""}, func(*Attempt) R { return job() }, true)
+}
+
+// TryGo is like [Sharded.Go], but returns [ErrClosed], once the nursery is waited for, instead of panicking.
+func (nursery *Sharded[R]) TryGo(job func() R) error {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	shard := nursery.next.Add(1) % uint64(len(nursery.shards))
+
+	return nursery.shards[shard].TryGo(job)
+}
@@ -0,0 +1,32 @@
+package nursery_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestTryGo_Closed(t *testing.T) {
+	t.Parallel()
+
+	for name, subject := range map[string]interface {
+		TryGo(job func() int) error
+		Wait() []int
+	}{
+		"Unbounded": nursery.NewUnbounded[int](),
+		"Sharded":   nursery.NewSharded[int](2),
+	} {
+		if err := subject.TryGo(func() int { return 1 }); err != nil {
+			t.Fatalf("%s: expected the job to be accepted, got %v", name, err)
+		}
+
+		if results := subject.Wait(); len(results) != 1 {
+			t.Fatalf("%s: expected the job's result, got %v", name, results)
+		}
+
+		if err := subject.TryGo(func() int { return 2 }); !errors.Is(err, nursery.ErrClosed) {
+			t.Fatalf("%s: expected a closed nursery to reject jobs, got %v", name, err)
+		}
+	}
+}
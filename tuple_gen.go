@@ -0,0 +1,162 @@
+// Code generated by tuplegen; DO NOT EDIT.
+
+package nursery
+
+// Tuple3 is like [Tuple], but with 3 components.
+type Tuple3[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Unpack is like [Tuple.Unpack].
+func (t Tuple3[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// NewTuple3 is like [NewTuple], but with 3 components.
+func NewTuple3[A, B, C any](a A, b B, c C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{a, b, c}
+}
+
+// mapError replaces the last component, if it is a non nil error, see [mapError].
+func (t Tuple3[A, B, C]) mapError(mapping func(error) error) any {
+	t.Third = mapError(t.Third, mapping)
+
+	return t
+}
+
+// Tuple4 is like [Tuple], but with 4 components.
+type Tuple4[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Unpack is like [Tuple.Unpack].
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.First, t.Second, t.Third, t.Fourth
+}
+
+// NewTuple4 is like [NewTuple], but with 4 components.
+func NewTuple4[A, B, C, D any](a A, b B, c C, d D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{a, b, c, d}
+}
+
+// mapError replaces the last component, if it is a non nil error, see [mapError].
+func (t Tuple4[A, B, C, D]) mapError(mapping func(error) error) any {
+	t.Fourth = mapError(t.Fourth, mapping)
+
+	return t
+}
+
+// Tuple5 is like [Tuple], but with 5 components.
+type Tuple5[A, B, C, D, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// Unpack is like [Tuple.Unpack].
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth
+}
+
+// NewTuple5 is like [NewTuple], but with 5 components.
+func NewTuple5[A, B, C, D, E any](a A, b B, c C, d D, e E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{a, b, c, d, e}
+}
+
+// mapError replaces the last component, if it is a non nil error, see [mapError].
+func (t Tuple5[A, B, C, D, E]) mapError(mapping func(error) error) any {
+	t.Fifth = mapError(t.Fifth, mapping)
+
+	return t
+}
+
+// Tuple6 is like [Tuple], but with 6 components.
+type Tuple6[A, B, C, D, E, F any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+	Sixth  F
+}
+
+// Unpack is like [Tuple.Unpack].
+func (t Tuple6[A, B, C, D, E, F]) Unpack() (A, B, C, D, E, F) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth
+}
+
+// NewTuple6 is like [NewTuple], but with 6 components.
+func NewTuple6[A, B, C, D, E, F any](a A, b B, c C, d D, e E, f F) Tuple6[A, B, C, D, E, F] {
+	return Tuple6[A, B, C, D, E, F]{a, b, c, d, e, f}
+}
+
+// mapError replaces the last component, if it is a non nil error, see [mapError].
+func (t Tuple6[A, B, C, D, E, F]) mapError(mapping func(error) error) any {
+	t.Sixth = mapError(t.Sixth, mapping)
+
+	return t
+}
+
+// Tuple7 is like [Tuple], but with 7 components.
+type Tuple7[A, B, C, D, E, F, G any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+}
+
+// Unpack is like [Tuple.Unpack].
+func (t Tuple7[A, B, C, D, E, F, G]) Unpack() (A, B, C, D, E, F, G) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh
+}
+
+// NewTuple7 is like [NewTuple], but with 7 components.
+func NewTuple7[A, B, C, D, E, F, G any](a A, b B, c C, d D, e E, f F, g G) Tuple7[A, B, C, D, E, F, G] {
+	return Tuple7[A, B, C, D, E, F, G]{a, b, c, d, e, f, g}
+}
+
+// mapError replaces the last component, if it is a non nil error, see [mapError].
+func (t Tuple7[A, B, C, D, E, F, G]) mapError(mapping func(error) error) any {
+	t.Seventh = mapError(t.Seventh, mapping)
+
+	return t
+}
+
+// Tuple8 is like [Tuple], but with 8 components.
+type Tuple8[A, B, C, D, E, F, G, H any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+	Eighth  H
+}
+
+// Unpack is like [Tuple.Unpack].
+func (t Tuple8[A, B, C, D, E, F, G, H]) Unpack() (A, B, C, D, E, F, G, H) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth, t.Seventh, t.Eighth
+}
+
+// NewTuple8 is like [NewTuple], but with 8 components.
+func NewTuple8[A, B, C, D, E, F, G, H any](a A, b B, c C, d D, e E, f F, g G, h H) Tuple8[A, B, C, D, E, F, G, H] {
+	return Tuple8[A, B, C, D, E, F, G, H]{a, b, c, d, e, f, g, h}
+}
+
+// mapError replaces the last component, if it is a non nil error, see [mapError].
+func (t Tuple8[A, B, C, D, E, F, G, H]) mapError(mapping func(error) error) any {
+	t.Eighth = mapError(t.Eighth, mapping)
+
+	return t
+}
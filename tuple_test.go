@@ -0,0 +1,77 @@
+package nursery_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestTuple5_Unpack(t *testing.T) {
+	t.Parallel()
+
+	first, second, third, fourth, fifth := nursery.NewTuple5(1, "two", 3.0, true, io.EOF).Unpack()
+
+	if first != 1 || second != "two" || third != 3.0 || !fourth || !errors.Is(fifth, io.EOF) {
+		t.Fatalf("expected the components in order, got %v %v %v %v %v", first, second, third, fourth, fifth)
+	}
+}
+
+func TestTuple8_Unpack(t *testing.T) {
+	t.Parallel()
+
+	tuple := nursery.NewTuple8(1, 2, 3, 4, 5, 6, 7, 8)
+	_, _, _, _, _, _, seventh, eighth := tuple.Unpack()
+
+	if seventh != 7 || eighth != 8 || tuple.Eighth != 8 {
+		t.Fatalf("expected the last components, got %v %v", seventh, eighth)
+	}
+}
+
+func TestTuple5_WrapsLastError(t *testing.T) {
+	t.Parallel()
+
+	type result = nursery.Tuple5[int, int, int, int, error]
+
+	unbounded := nursery.NewUnbounded[result](nursery.WithErrorWrapping())
+	unbounded.GoNamed("parse", func() result { return nursery.NewTuple5[int, int, int, int, error](1, 2, 3, 4, io.EOF) })
+
+	if err := unbounded.Wait()[0].Fifth; err.Error() != `job "parse": EOF` {
+		t.Fatalf("expected the last component to be wrapped, got %v", err)
+	}
+}
+
+func TestTuple3_Unpack(t *testing.T) {
+	t.Parallel()
+
+	value, metadata, err := nursery.NewTuple3(1, "meta", io.EOF).Unpack()
+
+	if value != 1 || metadata != "meta" || !errors.Is(err, io.EOF) {
+		t.Fatalf("expected the components in order, got %v %v %v", value, metadata, err)
+	}
+}
+
+func TestTuple4_Unpack(t *testing.T) {
+	t.Parallel()
+
+	tuple := nursery.NewTuple4(1, 2, 3, 4)
+	first, _, _, fourth := tuple.Unpack()
+
+	if first != 1 || fourth != 4 || tuple.Fourth != 4 {
+		t.Fatalf("expected the first and last components, got %v %v", first, fourth)
+	}
+}
+
+func TestTuple3_WrapsLastError(t *testing.T) {
+	t.Parallel()
+
+	type result = nursery.Tuple3[int, string, error]
+
+	unbounded := nursery.NewUnbounded[result](nursery.WithErrorWrapping())
+	unbounded.GoNamed("fetch", func() result { return nursery.NewTuple3[int, string, error](1, "meta", io.EOF) })
+
+	if err := unbounded.Wait()[0].Third; err.Error() != `job "fetch": EOF` {
+		t.Fatalf("expected the last component to be wrapped, got %v", err)
+	}
+}
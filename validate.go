@@ -0,0 +1,33 @@
+package nursery
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// nilJob describes the submission of a nil job, including the call site outside of this package,
+// as the job would otherwise panic on a worker goroutine far from the bug.
+func nilJob() string {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	method := "job"
+
+	for {
+		frame, more := frames.Next()
+
+		if !strings.HasPrefix(frame.Function, "github.com/lukasngl/nursery.") {
+			return fmt.Sprintf("%s called with nil job at %s:%d", method, frame.File, frame.Line)
+		}
+
+		method = frame.Function[strings.LastIndex(frame.Function, ".")+1:]
+		if strings.HasPrefix(method, "goChild") {
+			method = "Scope"
+		}
+
+		if !more {
+			return method + " called with nil job"
+		}
+	}
+}
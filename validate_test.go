@@ -0,0 +1,70 @@
+package nursery_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestNilJob_PanicsWithCallSite(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 1)
+	defer bounded.Wait()
+
+	unbounded := nursery.NewUnbounded[int]()
+	defer unbounded.Wait()
+
+	for name, submit := range map[string]func(){
+		"Go":       func() { bounded.Go(nil) },
+		"GoTagged": func() { bounded.GoTagged("tag", nil) },
+		"GoNamed":  func() { unbounded.GoNamed("name", nil) },
+	} {
+		message := fmt.Sprint(recovered(submit))
+		if !strings.HasPrefix(message, name+" called with nil job at ") || !strings.Contains(message, "validate_test.go:") {
+			t.Fatalf("expected the call site of %s, got %q", name, message)
+		}
+	}
+}
+
+func TestNilRun_Panics(t *testing.T) {
+	t.Parallel()
+
+	if message := recovered(func() { nursery.WithUnbounded[int](nil) }); message != "run must not be nil" {
+		t.Fatalf("expected nil run to panic, got %q", message)
+	}
+}
+
+// recovered returns the value, the function panicked with.
+func recovered(f func()) (value any) {
+	defer func() {
+		value = recover()
+	}()
+
+	f()
+
+	return nil
+}
+
+func TestNilFunction_Panics(t *testing.T) {
+	t.Parallel()
+
+	for name, call := range map[string]func(){
+		"Map": func() { _, _ = nursery.Map[int, int](context.TODO(), 1, nil, nil) },
+		"MapValues": func() {
+			_, _ = nursery.MapValues[string, int, int](context.TODO(), 1, nil, nil)
+		},
+		"WalkDir": func() { _ = nursery.WalkDir(context.TODO(), 1, ".", nil) },
+		"ProcessChunks": func() {
+			_, _ = nursery.ProcessChunks[int](context.TODO(), 1, strings.NewReader(""), 0, 1, nil)
+		},
+		"WithFinalizer": func() { nursery.WithFinalizer[int](nil) },
+	} {
+		if message := fmt.Sprint(recovered(call)); !strings.HasSuffix(message, " must not be nil") {
+			t.Fatalf("expected %s to reject a nil function, got %q", name, message)
+		}
+	}
+}
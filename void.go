@@ -0,0 +1,71 @@
+package nursery
+
+// Void is a nursery for jobs, that only perform side effects, like an [Unbounded] nursery without results:
+// it neither sends nor collects results, so jobs do not pay for a result type they do not need.
+// Options, that apply to results, like [WithSink] or [WithValidator], are ignored.
+type Void struct {
+	inner *Unbounded[struct{}]
+}
+
+var _ Waiter = (*Void)(nil)
+
+// NewVoid returns a new nursery, that runs all jobs in parallel and discards their results.
+func NewVoid(opts ...Option) *Void {
+	cfg := newConfig(opts)
+	cfg.void = true
+
+	nursery := &Void{inner: newUnbounded[struct{}](cfg)}
+
+	publish(cfg.expvar, nursery.Stats)
+
+	return nursery
+}
+
+// Go runs the code given via the closure in the background.
+func (nursery *Void) Go(job func()) {
+	if job == nil {
+		panic(nilJob())
+	}
+
+	nursery.inner.startSoon(JobInfo{Index: 0, Tag: "", Name: ""}, func() struct{} {
+		job()
+
+		return struct{}{}
+	})
+}
+
+// Wait blocks until all jobs are finished, like [Unbounded.Wait].
+func (nursery *Void) Wait() {
+	nursery.inner.Wait()
+}
+
+// Join is like [Void.Wait].
+func (nursery *Void) Join() {
+	nursery.Wait()
+}
+
+// Done is like [Unbounded.Done].
+func (nursery *Void) Done() <-chan struct{} {
+	return nursery.inner.Done()
+}
+
+// Stats is like [Unbounded.Stats], but never reports results.
+func (nursery *Void) Stats() Stats {
+	return nursery.inner.Stats()
+}
+
+// ForEach calls f for every element of in on a [Void] nursery, and blocks until all calls returned,
+// e.g. to notify a slice of subscribers in parallel.
+func ForEach[T any](in []T, f func(element T), opts ...Option) {
+	if f == nil {
+		panic("f must not be nil")
+	}
+
+	nursery := NewVoid(opts...)
+
+	for _, element := range in {
+		nursery.Go(func() { f(element) })
+	}
+
+	nursery.Wait()
+}
@@ -0,0 +1,79 @@
+package nursery_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestVoid_RunsAllJobs(t *testing.T) {
+	t.Parallel()
+
+	var ran atomic.Int64
+
+	void := nursery.NewVoid()
+
+	for range 10 {
+		void.Go(func() { ran.Add(1) })
+	}
+
+	void.Wait()
+
+	if ran.Load() != 10 {
+		t.Fatalf("expected all jobs to run, but %d did", ran.Load())
+	}
+
+	if stats := void.Stats(); stats.Completed != 10 || stats.Results != 0 {
+		t.Fatalf("expected all jobs to complete without results, got %+v", stats)
+	}
+}
+
+func TestVoid_RethrowsPanics(t *testing.T) {
+	t.Parallel()
+
+	void := nursery.NewVoid()
+	void.Go(func() { panic("boom") })
+
+	if panicErr := waitPanic(t, void.Wait); panicErr.Value != "boom" {
+		t.Fatalf("expected the job's panic, got %v", panicErr.Value)
+	}
+}
+
+func TestVoid_NilJob(t *testing.T) {
+	t.Parallel()
+
+	void := nursery.NewVoid()
+	defer void.Wait()
+
+	message := fmt.Sprint(recovered(func() { void.Go(nil) }))
+	if !strings.HasPrefix(message, "Go called with nil job at ") {
+		t.Fatalf("expected nil job to panic, got %q", message)
+	}
+}
+
+func TestForEach_VisitsAllElements(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mx      sync.Mutex
+		visited []int
+	)
+
+	nursery.ForEach([]int{3, 1, 2}, func(element int) {
+		mx.Lock()
+		defer mx.Unlock()
+
+		visited = append(visited, element)
+	})
+
+	slices.Sort(visited)
+
+	if !slices.Equal(visited, []int{1, 2, 3}) {
+		t.Fatalf("expected all elements to be visited, got %v", visited)
+	}
+}
@@ -0,0 +1,70 @@
+package nursery
+
+import (
+	"context"
+	"slices"
+)
+
+// WaitContext is like Wait, but returns early once ctx is done, for callers with hard deadlines:
+// it returns the results collected so far, in the order they were collected, alongside ctx.Err().
+// The remaining jobs are detached and keep running, so they are still collected by Wait.
+// Like Wait, it makes the nursery reject new jobs. Panics of jobs are only rethrown, if all jobs finished in time.
+func (nursery *Unbounded[R]) WaitContext(ctx context.Context) ([]R, error) {
+	select {
+	case <-nursery.Done():
+		return nursery.Wait(), nil
+	case <-ctx.Done():
+		return nursery.snapshot(), ctx.Err()
+	}
+}
+
+// WaitContext is like [Unbounded.WaitContext], but cancels the remaining jobs with the cause of ctx,
+// see [Bounded.Cancel], instead of detaching them.
+func (nursery *Bounded[R]) WaitContext(ctx context.Context) ([]R, error) {
+	select {
+	case <-nursery.Done():
+		return nursery.Wait(), nil
+	case <-ctx.Done():
+		nursery.abort(context.Cause(ctx))
+
+		return nursery.inner.snapshot(), ctx.Err()
+	}
+}
+
+// WaitContext is like [Unbounded.WaitContext], for the jobs of all shards.
+func (nursery *Sharded[R]) WaitContext(ctx context.Context) ([]R, error) {
+	select {
+	case <-nursery.Done():
+		return nursery.Wait(), nil
+	case <-ctx.Done():
+		var results []R
+
+		for _, shard := range nursery.shards {
+			results = append(results, shard.snapshot()...)
+		}
+
+		return results, ctx.Err()
+	}
+}
+
+// snapshot returns a copy of the results collected so far.
+func (nursery *Unbounded[R]) snapshot() []R {
+	nursery.streamMx.Lock()
+	defer nursery.streamMx.Unlock()
+
+	return slices.Clone(nursery.results)
+}
+
+// WaitUntil is like Wait, but ctx governs the jobs like the context given to [NewBounded],
+// for nurseries constructed before their deadline is known, e.g. via [NewBoundedSimple]:
+// once ctx is done, the nursery is cancelled with its cause, see [Bounded.Cancel],
+// so scheduled jobs are skipped, and WaitUntil returns the results of the jobs, that ran.
+// Unlike [Bounded.WaitContext], it still blocks until the running jobs returned.
+func (nursery *Bounded[R]) WaitUntil(ctx context.Context) []R {
+	stop := context.AfterFunc(ctx, func() {
+		nursery.abort(context.Cause(ctx))
+	})
+	defer stop()
+
+	return nursery.Wait()
+}
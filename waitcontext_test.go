@@ -0,0 +1,161 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestUnbounded_WaitContext(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewUnbounded[int]()
+	n.Go(func() int { return 1 })
+	n.Go(func() int { return 2 })
+
+	results, err := n.WaitContext(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	slices.Sort(results)
+
+	if !slices.Equal(results, []int{1, 2}) {
+		t.Fatalf("expected all results, got %v", results)
+	}
+}
+
+func TestUnbounded_WaitContext_Expired(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+
+	n := nursery.NewUnbounded[int]()
+	n.Go(func() int { return 1 })
+	n.Go(func() int {
+		<-release
+
+		return 2
+	})
+
+	// Wait until the first result is collected.
+	for n.Stats().Completed < 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	results, err := n.WaitContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline to be exceeded, got %v", err)
+	}
+
+	if !slices.Equal(results, []int{1}) {
+		t.Fatalf("expected the results collected so far, got %v", results)
+	}
+
+	// The detached job is still collected by Wait.
+	close(release)
+
+	results = n.Wait()
+	slices.Sort(results)
+
+	if !slices.Equal(results, []int{1, 2}) {
+		t.Fatalf("expected all results after Wait, got %v", results)
+	}
+}
+
+func TestBounded_WaitContext_CancelsJobs(t *testing.T) {
+	t.Parallel()
+
+	errDeadline := errors.New("deadline")
+
+	n := nursery.NewBounded[error](context.Background(), 1)
+	n.Go(func() error {
+		<-n.Context().Done()
+
+		return context.Cause(n.Context())
+	})
+	n.Go(func() error { return nil })
+
+	for n.Stats().Running < 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(errDeadline)
+
+	results, err := n.WaitContext(ctx)
+	if !errors.Is(err, context.Canceled) || len(results) != 0 {
+		t.Fatalf("expected the context error without results, got %v and %v", results, err)
+	}
+
+	if results := n.Wait(); len(results) != 1 || !errors.Is(results[0], errDeadline) {
+		t.Fatalf("expected the running job to be cancelled with the cause, and the queued one dropped, got %v", results)
+	}
+}
+
+func TestSharded_WaitContext(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewSharded[int](3)
+
+	for i := range 6 {
+		n.Go(func() int { return i })
+	}
+
+	results, err := n.WaitContext(context.Background())
+	if err != nil || len(results) != 6 {
+		t.Fatalf("expected all 6 results, got %v and %v", results, err)
+	}
+}
+
+func TestBounded_WaitUntil(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewBoundedSimple[int](2)
+
+	for job := range 4 {
+		n.Go(func() int { return job })
+	}
+
+	results := n.WaitUntil(context.Background())
+	slices.Sort(results)
+
+	if !slices.Equal(results, []int{0, 1, 2, 3}) {
+		t.Fatalf("expected all results, got %v", results)
+	}
+}
+
+func TestBounded_WaitUntil_Expired(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewBoundedSimple[error](1)
+	n.Go(func() error {
+		<-n.Context().Done()
+
+		return context.Cause(n.Context())
+	})
+	n.Go(func() error { return nil })
+
+	for n.Stats().Running < 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	results := n.WaitUntil(ctx)
+	if len(results) != 1 || !errors.Is(results[0], context.DeadlineExceeded) {
+		t.Fatalf("expected the running job to be cancelled by the deadline, got %v", results)
+	}
+
+	if n.Skipped() != 1 || !errors.Is(n.Token().Reason(), context.DeadlineExceeded) {
+		t.Fatalf("expected the scheduled job to be skipped, got %d and %v", n.Skipped(), n.Token().Reason())
+	}
+}
@@ -0,0 +1,87 @@
+package nursery
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// failures collects the errors reported by the results of jobs, see [Unbounded.WaitErr].
+type failures struct {
+	mx   sync.Mutex
+	errs []error
+}
+
+// add adds the error, if there is one.
+func (failures *failures) add(err error) {
+	if err == nil {
+		return
+	}
+
+	failures.mx.Lock()
+	defer failures.mx.Unlock()
+
+	failures.errs = append(failures.errs, err)
+}
+
+// join returns the errors joined via [errors.Join].
+func (failures *failures) join() error {
+	failures.mx.Lock()
+	defer failures.mx.Unlock()
+
+	return errors.Join(failures.errs...)
+}
+
+// WaitErr is like Wait, but returns the errors reported by the results of the jobs, see [WithErrorWrapping],
+// joined via [errors.Join], e.g. for nurseries of errors or [Result] values.
+// Each error is wrapped with the identity of its job, see [JobInfo], in the order they were collected.
+// Unlike [errgroup.Group.Wait], no error but the first one is lost.
+//
+// By default, these are the errors of the retained results, i.e. the ones Wait returns,
+// unless they are streamed, see [Unbounded.Stream].
+// With [WithErrorCollection], the errors of results, that are not retained, are included as well.
+//
+// [errgroup.Group.Wait]: https://pkg.go.dev/golang.org/x/sync/errgroup#Group.Wait
+func (nursery *Unbounded[R]) WaitErr() error {
+	nursery.Wait()
+
+	return nursery.failures.join()
+}
+
+// WaitErr is like [Unbounded.WaitErr].
+func (nursery *Bounded[R]) WaitErr() error {
+	nursery.Wait()
+
+	return nursery.inner.failures.join()
+}
+
+// WaitErr is like [Unbounded.WaitErr], for the jobs of all shards.
+func (nursery *Sharded[R]) WaitErr() error {
+	nursery.Wait()
+
+	errs := make([]error, 0, len(nursery.shards))
+	for _, shard := range nursery.shards {
+		errs = append(errs, shard.failures.join())
+	}
+
+	return errors.Join(errs...)
+}
+
+// failure returns the error reported by the result, if there is one, wrapped with the identity of the job.
+// Errors wrapped already, see [WithErrorWrapping], are returned as they are.
+func (nursery *Unbounded[R]) failure(job JobInfo, result R) error {
+	err := errorOf(result)
+	if err == nil || nursery.wrapErrors {
+		return err
+	}
+
+	return fmt.Errorf("%s: %w", job, err)
+}
+
+// retainFailure keeps the error reported by the retained result for WaitErr,
+// unless the errors of all results are kept already, see [WithErrorCollection].
+func (nursery *Unbounded[R]) retainFailure(collected indexed[R]) {
+	if !nursery.collectErrors {
+		nursery.failures.add(collected.failure)
+	}
+}
@@ -0,0 +1,107 @@
+package nursery_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestUnbounded_WaitErr(t *testing.T) {
+	t.Parallel()
+
+	errUnexpected := errors.New("unexpected")
+
+	n := nursery.NewUnbounded[error](nursery.WithErrorCollection())
+	n.GoNamed("read", func() error { return io.EOF })
+	n.GoNamed("parse", func() error { return errUnexpected })
+	n.Go(func() error { return nil })
+
+	err := n.WaitErr()
+	if !errors.Is(err, io.EOF) || !errors.Is(err, errUnexpected) {
+		t.Fatalf("expected both errors, got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), `job "read": EOF`) || !strings.Contains(err.Error(), `job "parse": unexpected`) {
+		t.Fatalf("expected the errors to be wrapped with the jobs, got %q", err)
+	}
+}
+
+func TestBounded_WaitErr_Results(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewBoundedSimple[nursery.Result[int]](2, nursery.WithErrorCollection())
+	n.Go(func() nursery.Result[int] { return nursery.Ok(1) })
+	n.GoNamed("fetch", func() nursery.Result[int] { return nursery.Err[int](io.EOF) })
+
+	if err := n.WaitErr(); err == nil || err.Error() != `job "fetch": EOF` {
+		t.Fatalf("expected the error of the failed result, got %v", err)
+	}
+}
+
+func TestBounded_WaitErr_None(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewBoundedSimple[error](2)
+	n.Go(func() error { return nil })
+
+	if err := n.WaitErr(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestUnbounded_WaitErr_WrappedOnce(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewUnbounded[error](nursery.WithErrorWrapping(), nursery.WithErrorCollection())
+	n.GoNamed("read", func() error { return io.EOF })
+
+	if err := n.WaitErr(); err == nil || err.Error() != `job "read": EOF` {
+		t.Fatalf("expected the error to be wrapped once, got %v", err)
+	}
+}
+
+func TestSharded_WaitErr(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewSharded[error](2)
+	n.Go(func() error { return io.EOF })
+	n.Go(func() error { return io.ErrUnexpectedEOF })
+
+	if err := n.WaitErr(); !errors.Is(err, io.EOF) || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected the errors of both shards, got %v", err)
+	}
+}
+
+func TestUnbounded_WaitErr_RetainedResults(t *testing.T) {
+	t.Parallel()
+
+	n := nursery.NewUnbounded[error]()
+	n.GoNamed("read", func() error { return io.EOF })
+	n.Go(func() error { return nil })
+
+	// Without collection, the errors of the retained results are still wrapped with their jobs.
+	if err := n.WaitErr(); err == nil || err.Error() != `job "read": EOF` {
+		t.Fatalf("expected the error of the result, got %v", err)
+	}
+}
+
+func TestUnbounded_WaitErr_NotRetained(t *testing.T) {
+	t.Parallel()
+
+	sunk := nursery.NewUnbounded[error](nursery.WithSink(func(error) {}))
+	sunk.Go(func() error { return io.EOF })
+
+	if err := sunk.WaitErr(); err != nil {
+		t.Fatalf("expected no errors to be kept for results handed to the sink, got %v", err)
+	}
+
+	collected := nursery.NewUnbounded[error](nursery.WithSink(func(error) {}), nursery.WithErrorCollection())
+	collected.GoNamed("read", func() error { return io.EOF })
+
+	if err := collected.WaitErr(); err == nil || err.Error() != `job "read": EOF` {
+		t.Fatalf("expected the error to be collected, got %v", err)
+	}
+}
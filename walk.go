@@ -0,0 +1,53 @@
+package nursery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+)
+
+// WalkDir walks the file tree rooted at root like [filepath.WalkDir],
+// but calls fn for every entry on a [Bounded] nursery running at most n calls in parallel.
+// Errors returned by fn and errors reading directories are joined, instead of stopping the walk.
+// As fn runs in the background, it can not skip directories via [fs.SkipDir].
+//
+// Once ctx is done, the walk stops, queued entries are dropped and its error is returned as well.
+// WalkDir always waits for all running calls of fn to return.
+//
+//nolint:varnamelen // n is perfectly fine
+func WalkDir(
+	ctx context.Context,
+	n int,
+	root string,
+	fn func(ctx context.Context, path string, entry fs.DirEntry) error,
+) error {
+	if fn == nil {
+		panic("fn must not be nil")
+	}
+
+	nursery := NewBounded[error](ctx, n)
+
+	var walkErrs []error
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		if err != nil {
+			walkErrs = append(walkErrs, fmt.Errorf("walk %s: %w", path, err))
+
+			return nil
+		}
+
+		nursery.Go(func() error {
+			return fn(ctx, path, entry)
+		})
+
+		return nil
+	})
+
+	return errors.Join(append(append(walkErrs, err), nursery.Wait()...)...)
+}
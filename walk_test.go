@@ -0,0 +1,103 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWalkDir_VisitsAllEntries(t *testing.T) {
+	t.Parallel()
+
+	root := tree(t, "a", "b/c", "b/d/e")
+
+	var (
+		mx      sync.Mutex
+		visited []string
+	)
+
+	err := nursery.WalkDir(context.TODO(), 2, root, func(_ context.Context, path string, _ fs.DirEntry) error {
+		rel, err := filepath.Rel(root, path)
+
+		mx.Lock()
+		visited = append(visited, filepath.ToSlash(rel))
+		mx.Unlock()
+
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slices.Sort(visited)
+
+	if want := []string{".", "a", "b", "b/c", "b/d", "b/d/e"}; !slices.Equal(visited, want) {
+		t.Fatalf("expected %v, got %v", want, visited)
+	}
+}
+
+func TestWalkDir_JoinsErrors(t *testing.T) {
+	t.Parallel()
+
+	root := tree(t, "a", "b", "c")
+	errFailed := errors.New("failed")
+
+	err := nursery.WalkDir(context.TODO(), 2, root, func(_ context.Context, _ string, entry fs.DirEntry) error {
+		if entry.IsDir() {
+			return nil
+		}
+
+		return errFailed
+	})
+
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) || len(joined.Unwrap()) != 3 || !errors.Is(err, errFailed) {
+		t.Fatalf("expected the errors of all 3 files, got %v", err)
+	}
+}
+
+func TestWalkDir_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	root := tree(t, "a", "b", "c")
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	err := nursery.WalkDir(ctx, 2, root, func(context.Context, string, fs.DirEntry) error {
+		t.Error("expected no entry to be visited")
+
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the walk to be cancelled, got %v", err)
+	}
+}
+
+// tree creates the given files in a temporary directory, including their parent directories.
+func tree(t *testing.T, files ...string) string {
+	t.Helper()
+
+	root := t.TempDir()
+
+	for _, file := range files {
+		path := filepath.Join(root, filepath.FromSlash(file))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return root
+}
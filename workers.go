@@ -0,0 +1,44 @@
+package nursery
+
+import "time"
+
+// WorkerStats describes the utilization of a single worker of a [Bounded] nursery,
+// i.e. one of the slots jobs are run in.
+// A worker is idle from the moment it was needed first, whenever it is not running a job.
+type WorkerStats struct {
+	Jobs int
+	Busy time.Duration
+	Idle time.Duration
+}
+
+// Workers returns the utilization of the nursery's workers so far.
+// Workers that are busy most of the time hint at a bound that is too low,
+// while uneven busy times hint at uneven job sizes.
+// Nurseries created with [WithInline] do not use workers.
+func (nursery *Bounded[R]) Workers() []WorkerStats {
+	return nursery.slots.workers()
+}
+
+// GoroutineStats counts the goroutines a nursery started to run jobs,
+// and how often a job was run on a goroutine, that was started before.
+type GoroutineStats struct {
+	Created int64
+	Reused  int64
+}
+
+// Goroutines returns the goroutine churn of the nursery so far,
+// which starts a goroutine for every job, so nothing is reused.
+func (nursery *Unbounded[R]) Goroutines() GoroutineStats {
+	return GoroutineStats{Created: nursery.spawned.Load(), Reused: 0}
+}
+
+// Goroutines returns the goroutine churn of the nursery's workers so far.
+// Jobs run by pre-warmed or idle workers, see [WithPrewarm] and [WithIdleTimeout],
+// or by a worker right after its previous job count as reused.
+// Nurseries created with [WithInline] do not use workers.
+func (nursery *Bounded[R]) Goroutines() GoroutineStats {
+	return GoroutineStats{
+		Created: nursery.pool.created.Load(),
+		Reused:  nursery.pool.reused.Load() + int64(nursery.slots.reused()),
+	}
+}
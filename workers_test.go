@@ -0,0 +1,178 @@
+package nursery_test
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestBounded_Workers(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 2)
+
+	for job := range 6 {
+		bounded.Go(func() int {
+			time.Sleep(time.Millisecond)
+
+			return job
+		})
+	}
+
+	bounded.Wait()
+
+	workers := bounded.Workers()
+	if len(workers) == 0 || len(workers) > 2 {
+		t.Fatalf("expected at most 2 workers, got %v", workers)
+	}
+
+	jobs := 0
+
+	for _, worker := range workers {
+		jobs += worker.Jobs
+
+		if worker.Busy < time.Duration(worker.Jobs)*time.Millisecond {
+			t.Fatalf("expected worker to be busy for its jobs, got %+v", worker)
+		}
+	}
+
+	if jobs != 6 {
+		t.Fatalf("expected workers to run all 6 jobs, got %d", jobs)
+	}
+}
+
+func TestBounded_Goroutines(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 2)
+
+	for job := range 6 {
+		bounded.Go(func() int {
+			time.Sleep(time.Millisecond)
+
+			return job
+		})
+	}
+
+	bounded.Wait()
+
+	stats := bounded.Goroutines()
+	if stats.Created < 1 || stats.Created > 2 || stats.Created+stats.Reused != 6 {
+		t.Fatalf("expected at most 2 goroutines to run all 6 jobs, got %+v", stats)
+	}
+}
+
+func TestUnbounded_Goroutines(t *testing.T) {
+	t.Parallel()
+
+	unbounded := nursery.NewUnbounded[int]()
+
+	for job := range 6 {
+		unbounded.Go(func() int { return job })
+	}
+
+	unbounded.Wait()
+
+	if stats := unbounded.Goroutines(); stats.Created != 6 || stats.Reused != 0 {
+		t.Fatalf("expected a goroutine per job, got %+v", stats)
+	}
+}
+
+func TestWithWorkerInit_RunsOncePerWorker(t *testing.T) {
+	t.Parallel()
+
+	for name, opts := range map[string][]nursery.Option{
+		"on demand":  {nursery.WithIdleTimeout(time.Hour)},
+		"pre-warmed": {nursery.WithPrewarm()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var initialized, tornDown atomic.Int64
+
+			bounded := nursery.NewBounded[int](context.TODO(), 3, append(opts,
+				nursery.WithWorkerInit(func() { initialized.Add(1) }),
+				nursery.WithWorkerTeardown(func() { tornDown.Add(1) }),
+			)...)
+
+			for job := range 12 {
+				bounded.Go(func() int {
+					time.Sleep(time.Millisecond)
+
+					return job
+				})
+			}
+
+			bounded.Wait()
+
+			created := bounded.Goroutines().Created
+			if initialized.Load() != created || tornDown.Load() != created {
+				t.Fatalf("expected each of the %d workers to be initialized and torn down once, got %d and %d",
+					created, initialized.Load(), tornDown.Load())
+			}
+		})
+	}
+}
+
+func TestBounded_QueuedJobsDoNotStartGoroutines(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[int](context.TODO(), 4)
+	release := make(chan struct{})
+
+	for job := range 10_000 {
+		bounded.Go(func() int {
+			<-release
+
+			return job
+		})
+	}
+
+	if created := bounded.Goroutines().Created; created > 4 {
+		t.Fatalf("expected at most 4 workers for the queued jobs, got %d", created)
+	}
+
+	close(release)
+
+	if results := bounded.Wait(); len(results) != 10_000 {
+		t.Fatalf("expected all jobs to run, got %d results", len(results))
+	}
+}
+
+// benchmarkQueued submits jobs, that block until all are submitted, and reports the goroutines they occupied.
+func benchmarkQueued(b *testing.B, submit func(job func() int), wait func() []int) {
+	b.Helper()
+	b.ReportAllocs()
+
+	// The jobs are released, once the goroutines are counted.
+	release := make(chan struct{})
+	baseline := runtime.NumGoroutine()
+
+	for job := range b.N {
+		submit(func() int {
+			<-release
+
+			return job
+		})
+	}
+
+	b.ReportMetric(float64(runtime.NumGoroutine()-baseline)/float64(b.N), "goroutines/op")
+	close(release)
+	wait()
+}
+
+func BenchmarkBounded_QueuedJobs(b *testing.B) {
+	bounded := nursery.NewBounded[int](context.TODO(), runtime.GOMAXPROCS(0))
+
+	benchmarkQueued(b, bounded.Go, bounded.Wait)
+}
+
+func BenchmarkUnbounded_QueuedJobs(b *testing.B) {
+	unbounded := nursery.NewUnbounded[int]()
+
+	benchmarkQueued(b, unbounded.Go, unbounded.Wait)
+}
@@ -0,0 +1,43 @@
+package nursery_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+func TestWithErrorWrapping_Errors(t *testing.T) {
+	t.Parallel()
+
+	bounded := nursery.NewBounded[error](context.TODO(), 1, nursery.WithErrorWrapping())
+	bounded.Go(func() error { return nil })
+	bounded.GoTagged("db", func() error { return io.EOF })
+
+	messages := []string{}
+
+	for _, err := range bounded.Wait() {
+		if err != nil {
+			messages = append(messages, err.Error())
+		}
+	}
+
+	if !slices.Equal(messages, []string{`job 1 (tag "db"): EOF`}) {
+		t.Fatalf("expected the failed job to be identified, got %v", messages)
+	}
+}
+
+func TestWithErrorWrapping_Tuples(t *testing.T) {
+	t.Parallel()
+
+	results := nursery.WithUnbounded(func(Go nursery.Go[nursery.Tuple[int, error]]) {
+		Go(func() nursery.Tuple[int, error] { return nursery.NewTuple(0, io.EOF) })
+	}, nursery.WithErrorWrapping())
+
+	if err := results[0].Second; !errors.Is(err, io.EOF) || err.Error() != "job 0: EOF" {
+		t.Fatalf("expected the error to be wrapped, got %v", err)
+	}
+}
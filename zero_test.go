@@ -0,0 +1,71 @@
+package nursery_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lukasngl/nursery"
+)
+
+// crawler embeds a nursery, like a [sync.WaitGroup].
+type crawler struct {
+	nursery.Unbounded[int]
+}
+
+func TestUnbounded_ZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var crawler crawler
+
+	for i := range 3 {
+		crawler.Go(func() int { return i })
+	}
+
+	results := crawler.Wait()
+	slices.Sort(results)
+
+	if !slices.Equal(results, []int{0, 1, 2}) {
+		t.Fatalf("expected the results of all jobs, got %v", results)
+	}
+}
+
+func TestUnbounded_ZeroValueWithoutJobs(t *testing.T) {
+	t.Parallel()
+
+	var unbounded nursery.Unbounded[int]
+
+	if results := unbounded.Wait(); results == nil || len(results) != 0 {
+		t.Fatalf("expected no results, got %#v", results)
+	}
+}
+
+func TestUnbounded_ZeroValueDone(t *testing.T) {
+	t.Parallel()
+
+	var unbounded nursery.Unbounded[int]
+
+	unbounded.Go(func() int { return 1 })
+
+	<-unbounded.Done()
+
+	if stats := unbounded.Stats(); stats.Completed != 1 {
+		t.Fatalf("expected the job to be finished, got %+v", stats)
+	}
+}
+
+func TestUnbounded_ZeroValueStats(t *testing.T) {
+	t.Parallel()
+
+	var unbounded nursery.Unbounded[int]
+
+	if stats := unbounded.Stats(); stats != (nursery.Stats{}) {
+		t.Fatalf("expected empty stats, got %+v", stats)
+	}
+
+	unbounded.Go(func() int { return 1 })
+	unbounded.Wait()
+
+	if stats := unbounded.Stats(); stats.Completed != 1 || stats.Peak != 1 {
+		t.Fatalf("expected one completed job, got %+v", stats)
+	}
+}